@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"time"
+
+	"ROFT_examples/collections"
+)
+
+// stackPolicies are the growth policies compared by the bench subcommand, in output order
+var stackPolicies = []struct {
+	name   string
+	policy collections.GrowthPolicy
+}{
+	{"append", nil},
+	{"exact", collections.GrowExact},
+	{"doubling", collections.GrowDoubling},
+	{"chunked", collections.GrowChunked(256)},
+}
+
+// benchResult holds the measurements of one growth policy, averaged over all rounds
+type benchResult struct {
+	Policy      string `json:"policy"`
+	PushNsPerOp int64  `json:"push_ns_per_op"`
+	PopNsPerOp  int64  `json:"pop_ns_per_op"`
+	PushAllocs  uint64 `json:"push_allocs"`
+}
+
+// benchStack pushes n integers onto a stack growing according to policy and pops them again, rounds
+// times, and returns the average duration per push and pop and the average allocations per round of pushes
+func benchStack(policy collections.GrowthPolicy, n, rounds int) (push, pop time.Duration, allocs uint64) {
+	var before, after runtime.MemStats
+	for range rounds {
+		s := collections.NewStackWithGrowth[int](policy)
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		for i := range n {
+			s.Push(i)
+		}
+		push += time.Since(start)
+		runtime.ReadMemStats(&after)
+		allocs += after.Mallocs - before.Mallocs
+		start = time.Now()
+		for !s.IsEmpty() {
+			s.Pop()
+		}
+		pop += time.Since(start)
+	}
+	ops := time.Duration(n * rounds)
+	return push / ops, pop / ops, allocs / uint64(rounds)
+}
+
+func runBench(args []string, stdout, stderr io.Writer) error {
+	fs, n, format := newFlagSet("bench", 10_000, stderr)
+	rounds := fs.Int("rounds", 100, "number of times each policy pushes and pops n integers")
+	if err := parseFlags(fs, args, n); err != nil {
+		return err
+	}
+	if *n < 1 || *rounds < 1 {
+		return fmt.Errorf("n and rounds must be positive, got %d and %d", *n, *rounds)
+	}
+	results := make([]benchResult, len(stackPolicies))
+	for i, p := range stackPolicies {
+		push, pop, allocs := benchStack(p.policy, *n, *rounds)
+		results[i] = benchResult{p.name, push.Nanoseconds(), pop.Nanoseconds(), allocs}
+	}
+	return output(stdout, *format, results, func() {
+		fmt.Fprintf(stdout, "%-10s %12s %12s %12s\n", "policy", "push ns/op", "pop ns/op", "push allocs")
+		for _, r := range results {
+			fmt.Fprintf(stdout, "%-10s %12d %12d %12d\n", r.Policy, r.PushNsPerOp, r.PopNsPerOp, r.PushAllocs)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"ROFT_examples/collections"
+	"ROFT_examples/iterx"
+)
+
+const cliUsage = `usage: %s <command> [flags]
+
+commands:
+  stack       push n integers and print the stack and its neighbouring pairs
+  iter        split the integers 0..n-1 into chunks and sliding windows
+  maps        build a map with n entries and print it with the map helpers
+  invariants  run random operation sequences against the containers and validate them
+  bench       time stack pushes and pops for each growth policy
+
+Run '%[1]s <command> -h' for the flags of a command.
+`
+
+// cliCommands maps the subcommand names to their implementations
+var cliCommands = map[string]func(args []string, stdout, stderr io.Writer) error{
+	"stack":      runStack,
+	"iter":       runIter,
+	"maps":       runMaps,
+	"invariants": runInvariants,
+	"bench":      runBench,
+}
+
+// run executes the subcommand named by args[0] with its output going to stdout and stderr and returns
+// the process exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || cliCommands[args[0]] == nil {
+		fmt.Fprintf(stderr, cliUsage, os.Args[0])
+		return 2
+	}
+	if err := cliCommands[args[0]](args[1:], stdout, stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "%s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+// newFlagSet creates the flags shared by all subcommands: the input size n and the output format.
+// Usage and parse errors are written to stderr.
+func newFlagSet(name string, n int, stderr io.Writer) (fs *flag.FlagSet, size *int, format *string) {
+	fs = flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	size = fs.Int("n", n, "number of input elements")
+	format = fs.String("format", "text", "output format: text or json")
+	return fs, size, format
+}
+
+// parseFlags parses args into fs and checks that the input size n is not negative
+func parseFlags(fs *flag.FlagSet, args []string, n *int) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *n < 0 {
+		return fmt.Errorf("n must not be negative, got %d", *n)
+	}
+	return nil
+}
+
+// output writes v as JSON or calls text, depending on format
+func output(w io.Writer, format string, v any, text func()) error {
+	switch format {
+	case "text":
+		text()
+		return nil
+	case "json":
+		return json.NewEncoder(w).Encode(v)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func runStack(args []string, stdout, stderr io.Writer) error {
+	fs, n, format := newFlagSet("stack", 5, stderr)
+	if err := parseFlags(fs, args, n); err != nil {
+		return err
+	}
+	s := collections.NewStack[int]()
+	for i := range *n {
+		s.Push(i)
+	}
+	var pairs [][2]int
+	for a, b := range iterx.Pairwise(s.All()) {
+		pairs = append(pairs, [2]int{a, b})
+	}
+	return output(stdout, *format, map[string]any{"elements": slices.Collect(s.All()), "pairs": pairs}, func() {
+		PrintStack(stdout, s)
+		PrintPairs(stdout, s)
+	})
+}
+
+func runIter(args []string, stdout, stderr io.Writer) error {
+	fs, n, format := newFlagSet("iter", 10, stderr)
+	size := fs.Int("size", 3, "chunk and window size")
+	if err := parseFlags(fs, args, n); err != nil {
+		return err
+	}
+	if *size < 1 {
+		return fmt.Errorf("size must be positive, got %d", *size)
+	}
+	values := make([]int, *n)
+	for i := range values {
+		values[i] = i
+	}
+	chunks := slices.Collect(iterx.ChunkSlice(values, *size))
+	windows := slices.Collect(iterx.WindowsSlice(values, *size))
+	return output(stdout, *format, map[string]any{"chunks": chunks, "windows": windows}, func() {
+		fmt.Fprintln(stdout, "chunks: ", chunks)
+		fmt.Fprintln(stdout, "windows:", windows)
+	})
+}
+
+func runMaps(args []string, stdout, stderr io.Writer) error {
+	fs, n, format := newFlagSet("maps", 4, stderr)
+	if err := parseFlags(fs, args, n); err != nil {
+		return err
+	}
+	m := make(map[string]int, *n)
+	for i := range *n {
+		m[fmt.Sprintf("k%03d", i)] = i + 1
+	}
+	return output(stdout, *format, m, func() { STLfunctions(stdout, m) })
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"ROFT_examples/collections"
+)
+
+// invariantChecks feeds the same random operation sequences of length n to every container driver and
+// returns the first error of each
+func invariantChecks(n, rounds int, seed uint64) map[string]error {
+	less := func(a, b int) bool { return a < b }
+	drivers := map[string]func(ops []byte) error{
+		"TreeMap":   func(ops []byte) error { return collections.RunMapOps(collections.NewTreeMap[int, int](), ops) },
+		"AVLTree":   func(ops []byte) error { return collections.RunMapOps(collections.NewAVLTree[int, int](), ops) },
+		"SplayTree": func(ops []byte) error { return collections.RunMapOps(collections.NewSplayTree[int, int](), ops) },
+		"Treap": func(ops []byte) error {
+			t := collections.NewTreap[int, int]()
+			t.SetRand(rand.New(rand.NewPCG(seed, 0)))
+			return collections.RunMapOps(t, ops)
+		},
+		"BTreeMap":      func(ops []byte) error { return collections.RunMapOps(collections.NewBTreeMap[int, int](2), ops) },
+		"PriorityQueue": func(ops []byte) error { return collections.RunHeapOps(collections.NewPriorityQueue(less), ops) },
+		"PairingHeap":   func(ops []byte) error { return collections.RunHeapOps(collections.NewPairingHeap(less), ops) },
+		"MinMaxHeap":    func(ops []byte) error { return collections.RunMinMaxHeapOps(collections.NewMinMaxHeap[int](), ops) },
+		"Deque":         func(ops []byte) error { return collections.RunDequeOps(collections.NewDeque[int](), ops) },
+		"Stack":         func(ops []byte) error { return collections.RunStackOps(collections.NewStack[int](), ops) },
+	}
+	rng := rand.New(rand.NewPCG(seed, seed))
+	results := make(map[string]error, len(drivers))
+	for name := range drivers {
+		results[name] = nil
+	}
+	for range rounds {
+		ops := make([]byte, n)
+		for i := range ops {
+			// A small key range makes overwrites and deletions of present keys likely
+			ops[i] = byte(rng.IntN(64))
+		}
+		for name, drive := range drivers {
+			if results[name] == nil {
+				results[name] = drive(ops)
+			}
+		}
+	}
+	return results
+}
+
+func runInvariants(args []string, stdout, stderr io.Writer) error {
+	fs, n, format := newFlagSet("invariants", 1000, stderr)
+	rounds := fs.Int("rounds", 20, "number of random operation sequences")
+	seed := fs.Uint64("seed", 1, "seed of the operation generator")
+	if err := parseFlags(fs, args, n); err != nil {
+		return err
+	}
+	results := invariantChecks(*n, *rounds, *seed)
+	report := make(map[string]string, len(results))
+	var failed []string
+	for name, err := range results {
+		report[name] = "ok"
+		if err != nil {
+			report[name] = err.Error()
+			failed = append(failed, name)
+		}
+	}
+	err := output(stdout, *format, report, func() {
+		for _, name := range slices.Sorted(maps.Keys(report)) {
+			fmt.Fprintf(stdout, "%-14s %s\n", name, report[name])
+		}
+	})
+	if len(failed) > 0 {
+		slices.Sort(failed)
+		return errors.Join(err, fmt.Errorf("invariants violated by %s", strings.Join(failed, ", ")))
+	}
+	return err
+}
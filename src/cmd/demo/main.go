@@ -0,0 +1,57 @@
+// Command demo exercises the collections, iterx and maputil packages from the command line.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"ROFT_examples/collections"
+	"ROFT_examples/iterx"
+	"ROFT_examples/maputil"
+)
+
+// orStdout returns w, or os.Stdout if w is nil
+func orStdout(w io.Writer) io.Writer {
+	if w == nil {
+		return os.Stdout
+	}
+	return w
+}
+
+// PrintStack writes the elements of s to w, one per line. A nil w writes to os.Stdout.
+func PrintStack[T any](w io.Writer, s *collections.Stack[T]) {
+	// Iterate over stack using the All() function
+	iterx.FprintSeq(orStdout(w), s.All())
+}
+
+// PrintPairs writes the neighbouring pairs of s to w, one per line. A nil w writes to os.Stdout.
+func PrintPairs[T any](w io.Writer, s *collections.Stack[T]) {
+	iterx.FprintSeq2(orStdout(w), iterx.Pairwise(s.All()))
+}
+
+// STLfunctions writes m and its sorted values and keys to w. A nil w writes to os.Stdout.
+func STLfunctions(w io.Writer, m map[string]int) {
+	w = orStdout(w)
+	fmt.Fprint(w, maputil.FormatMap(m))
+
+	s_v_sorted := maputil.CollectMapsValuesSorted(m)
+	for _, v := range s_v_sorted {
+		fmt.Fprintln(w, v)
+	}
+
+	// Print values in reverse order using slices.Backward
+	for _, v := range slices.Backward(s_v_sorted) {
+		fmt.Fprintln(w, v)
+	}
+
+	s_k_sorted := maputil.CollectMapKeysSorted(m)
+	for _, k := range s_k_sorted {
+		fmt.Fprintln(w, k)
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,169 @@
+package collections
+
+import "iter"
+
+const (
+	arcT1 = iota // resident, seen once recently
+	arcT2        // resident, seen at least twice
+	arcB1        // ghost entries evicted from T1
+	arcB2        // ghost entries evicted from T2
+)
+
+type arcItem[K comparable, V any] struct {
+	key   K
+	value V
+	list  int
+}
+
+// ARC is a fixed-capacity adaptive replacement cache. It balances between recency and frequency
+// by remembering the keys of recently evicted entries and adapting to hits on them.
+type ARC[K comparable, V any] struct {
+	capacity int
+	// p is the target size of T1
+	p       int
+	index   map[K]*DListElement[*arcItem[K, V]]
+	lists   [4]DList[*arcItem[K, V]]
+	onEvict func(K, V)
+	stats   CacheStats
+}
+
+// NewARC creates a cache holding at most capacity entries, which is at least 1
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	return &ARC[K, V]{capacity: max(capacity, 1), index: make(map[K]*DListElement[*arcItem[K, V]])}
+}
+
+// OnEvict registers fn to be called with every entry that is evicted to make room
+func (c *ARC[K, V]) OnEvict(fn func(key K, value V)) {
+	c.onEvict = fn
+}
+
+// moveTo moves e to the front of list
+func (c *ARC[K, V]) moveTo(e *DListElement[*arcItem[K, V]], list int) {
+	item := c.lists[e.Value.list].Remove(e)
+	item.list = list
+	c.index[item.key] = c.lists[list].PushFront(item)
+}
+
+// dropLRU removes the least recently used ghost entry of list
+func (c *ARC[K, V]) dropLRU(list int) {
+	if e := c.lists[list].Back(); e != nil {
+		delete(c.index, c.lists[list].Remove(e).key)
+	}
+}
+
+// replace evicts one resident entry into its ghost list
+func (c *ARC[K, V]) replace(hitB2 bool) {
+	if c.Len() < c.capacity {
+		return
+	}
+	t1 := c.lists[arcT1].Len()
+	from, to := arcT2, arcB2
+	if t1 > 0 && (t1 > c.p || (hitB2 && t1 == c.p)) {
+		from, to = arcT1, arcB1
+	}
+	e := c.lists[from].Back()
+	key, value := e.Value.key, e.Value.value
+	var zero V
+	e.Value.value = zero
+	c.moveTo(e, to)
+	if c.onEvict != nil {
+		c.onEvict(key, value)
+	}
+}
+
+// Get returns the value for key and promotes it to the frequently used entries
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	e, ok := c.index[key]
+	if !ok || e.Value.list >= arcB1 {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.moveTo(e, arcT2)
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without changing its position or the statistics
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.index[key]; ok && e.Value.list < arcB1 {
+		return e.Value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, evicting an entry if the cache is full
+func (c *ARC[K, V]) Put(key K, value V) {
+	if e, ok := c.index[key]; ok {
+		switch e.Value.list {
+		case arcB1:
+			// A recently evicted entry was needed again, favour recency
+			c.p = min(c.capacity, c.p+max(c.lists[arcB2].Len()/c.lists[arcB1].Len(), 1))
+			c.replace(false)
+		case arcB2:
+			c.p = max(0, c.p-max(c.lists[arcB1].Len()/c.lists[arcB2].Len(), 1))
+			c.replace(true)
+		}
+		e.Value.value = value
+		c.moveTo(e, arcT2)
+		return
+	}
+
+	t1, b1 := c.lists[arcT1].Len(), c.lists[arcB1].Len()
+	total := c.Len() + b1 + c.lists[arcB2].Len()
+	switch {
+	case t1+b1 >= c.capacity && t1 < c.capacity:
+		c.dropLRU(arcB1)
+		c.replace(false)
+	case t1+b1 >= c.capacity:
+		old := c.lists[arcT1].Remove(c.lists[arcT1].Back())
+		delete(c.index, old.key)
+		if c.onEvict != nil {
+			c.onEvict(old.key, old.value)
+		}
+	case total >= c.capacity:
+		if total >= 2*c.capacity {
+			c.dropLRU(arcB2)
+		}
+		c.replace(false)
+	}
+	c.index[key] = c.lists[arcT1].PushFront(&arcItem[K, V]{key: key, value: value, list: arcT1})
+}
+
+// Remove deletes key without calling the eviction callback and reports whether it was cached
+func (c *ARC[K, V]) Remove(key K) bool {
+	e, ok := c.index[key]
+	if !ok {
+		return false
+	}
+	list := e.Value.list
+	c.lists[list].Remove(e)
+	delete(c.index, key)
+	return list < arcB1
+}
+
+func (c *ARC[K, V]) Len() int {
+	return c.lists[arcT1].Len() + c.lists[arcT2].Len()
+}
+
+func (c *ARC[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *ARC[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// All returns an iterator over the cached entries, frequently used ones first and each group from most recent use
+func (c *ARC[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, list := range []int{arcT2, arcT1} {
+			for item := range c.lists[list].All() {
+				if !yield(item.key, item.value) {
+					return
+				}
+			}
+		}
+	}
+}
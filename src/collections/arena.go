@@ -0,0 +1,66 @@
+package collections
+
+// Arena hands out values of type T carved from large blocks, so a structure with millions of nodes
+// costs the garbage collector a few big objects instead of millions of small ones. A block is freed as a
+// whole once none of its values is reachable any more, so values returned with Free are reused by New
+// to keep a structure under insert/delete churn from pinning ever more blocks.
+//
+// A nil *Arena is valid and allocates every value separately on the heap. Arena is not safe for
+// concurrent use; each structure owns its own.
+type Arena[T any] struct {
+	block     []T
+	blockSize int
+	free      []*T
+}
+
+// NewArena creates an arena allocating blocks of blockSize values, which is raised to at least 1
+func NewArena[T any](blockSize int) *Arena[T] {
+	return &Arena[T]{blockSize: max(blockSize, 1)}
+}
+
+// New returns a pointer to a zero value, reusing a freed value if there is one
+func (a *Arena[T]) New() *T {
+	if a == nil {
+		return new(T)
+	}
+	if n := len(a.free); n > 0 {
+		v := a.free[n-1]
+		a.free[n-1] = nil
+		a.free = a.free[:n-1]
+		return v
+	}
+	return &a.Alloc(1)[0]
+}
+
+// Free zeroes *v, so it no longer keeps other values reachable, and lets New hand it out again.
+// v must have been returned by New and must not be used afterwards. Free does nothing on a nil arena.
+func (a *Arena[T]) Free(v *T) {
+	if a == nil {
+		return
+	}
+	var zero T
+	*v = zero
+	a.free = append(a.free, v)
+}
+
+// Alloc returns a zeroed slice of n values. Its capacity is n, so appending to it never overwrites
+// its neighbours. Requests larger than the block size get their own allocation.
+func (a *Arena[T]) Alloc(n int) []T {
+	if a == nil || n > a.blockSize {
+		return make([]T, n)
+	}
+	if len(a.block)+n > cap(a.block) {
+		a.block = make([]T, 0, a.blockSize)
+	}
+	i := len(a.block)
+	a.block = a.block[:i+n]
+	return a.block[i : i+n : i+n]
+}
+
+// Reset makes the arena start a new block and forgets the freed values, so the old blocks can be freed
+// once the values handed out from them are no longer referenced
+func (a *Arena[T]) Reset() {
+	if a != nil {
+		a.block, a.free = nil, nil
+	}
+}
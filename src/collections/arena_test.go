@@ -0,0 +1,109 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestArenaFree(t *testing.T) {
+	a := NewArena[[2]int](2)
+	v := a.New()
+	*v = [2]int{1, 2}
+	a.Free(v)
+	if *v != [2]int{} {
+		t.Errorf("freed value not zeroed: %v", *v)
+	}
+	if w := a.New(); w != v {
+		t.Error("New did not reuse the freed value")
+	}
+	var nilArena *Arena[int]
+	nilArena.Free(nilArena.New())
+}
+
+// TestArenaChurn checks that alternating inserts and deletes reuse the freed nodes. Without reuse every
+// insert would take a fresh slot from the block.
+func TestArenaChurn(t *testing.T) {
+	const rounds, blockSize = 1000, 4096
+	tm := NewTreeMapWithArena[int, int](blockSize)
+	avl := NewAVLTreeWithArena[int, int](blockSize)
+	splay := NewSplayTreeWithArena[int, int](blockSize)
+	treap := NewTreapWithArena[int, int](blockSize)
+	skip := NewSkipListWithArena[int, int](blockSize)
+	skip.SetRand(rand.New(rand.NewPCG(1, 2)))
+	list := NewListWithArena[int](blockSize)
+	dlist := NewDListWithArena[int](blockSize)
+	structures := []struct {
+		name  string
+		keep  func()
+		churn func(i int)
+		used  func() int
+	}{
+		{"TreeMap", func() { tm.Set(-1, -1) }, func(i int) { tm.Set(i, i); tm.Delete(i) }, func() int { return len(tm.nodes.block) }},
+		{"AVLTree", func() { avl.Set(-1, -1) }, func(i int) { avl.Set(i, i); avl.Delete(i) }, func() int { return len(avl.nodes.block) }},
+		{"SplayTree", func() { splay.Set(-1, -1) }, func(i int) { splay.Set(i, i); splay.Delete(i) }, func() int { return len(splay.nodes.block) }},
+		{"Treap", func() { treap.Set(-1, -1) }, func(i int) {
+			treap.Set(i, i)
+			if i%2 == 0 {
+				treap.Delete(i)
+			} else {
+				treap.DeleteRange(i, i+1)
+			}
+		}, func() int { return len(treap.nodes.block) }},
+		{"SkipList", func() { skip.Set(-1, -1) }, func(i int) { skip.Set(i, i); skip.Delete(i) }, func() int { return len(skip.nodes.block) }},
+		{"List", func() { list.PushBack(-1) }, func(i int) { list.Remove(list.PushBack(i)) }, func() int { return len(list.nodes.block) }},
+		{"DList", func() { dlist.PushBack(-1) }, func(i int) { dlist.Remove(dlist.PushBack(i)) }, func() int { return len(dlist.nodes.block) }},
+	}
+	for _, s := range structures {
+		// the entry kept alive makes the deletes go through the interior paths, not just the root
+		s.keep()
+		for i := range rounds {
+			s.churn(i)
+		}
+		if n := s.used(); n > 2 {
+			t.Errorf("%s: %d arena slots used after %d insert/delete rounds", s.name, n, rounds)
+		}
+	}
+	// Link slices are reused per level, so at most one slice of every level drawn is allocated besides
+	// the one of the kept entry
+	if n := len(skip.links.block); n > 2*skipListMaxLevel {
+		t.Errorf("SkipList: %d link slots used after %d insert/delete rounds", n, rounds)
+	}
+}
+
+// BenchmarkTreeMapGC measures the duration of a full collection with a large TreeMap live on the heap, once with every
+// node allocated separately and once with the nodes carved from arena blocks
+func BenchmarkTreeMapGC(b *testing.B) {
+	const entries = 1 << 20
+	for _, blockSize := range []int{0, 4096} {
+		b.Run(fmt.Sprintf("blockSize=%d", blockSize), func(b *testing.B) {
+			m := NewTreeMap[int, int]()
+			if blockSize > 0 {
+				m = NewTreeMapWithArena[int, int](blockSize)
+			}
+			for i := range entries {
+				m.Set(i, i)
+			}
+			for b.Loop() {
+				runtime.GC()
+			}
+			runtime.KeepAlive(m)
+		})
+	}
+}
+
+func TestListSpliceKeepsArena(t *testing.T) {
+	l, other := NewList[int](), NewListWithArena[int](16)
+	other.PushBack(1)
+	l.SpliceBack(other)
+	other.PushBack(2)
+	l.SpliceFront(other)
+	if other.nodes == nil || other.Len() != 0 {
+		t.Errorf("splice left other with arena %v and %d nodes", other.nodes, other.Len())
+	}
+	if got := slices.Collect(l.All()); !slices.Equal(got, []int{2, 1}) {
+		t.Errorf("spliced list holds %v", got)
+	}
+}
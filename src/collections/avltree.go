@@ -0,0 +1,353 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// AVLTree is a sorted map backed by an AVL tree. Its stricter balancing makes lookups faster than
+// in TreeMap at the cost of more rotations on updates.
+type AVLTree[K cmp.Ordered, V any] struct {
+	root  *avlNode[K, V]
+	size  int
+	nodes *Arena[avlNode[K, V]]
+}
+
+type avlNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *avlNode[K, V]
+	height      int
+}
+
+func NewAVLTree[K cmp.Ordered, V any]() *AVLTree[K, V] {
+	return &AVLTree[K, V]{}
+}
+
+// NewAVLTreeWithArena creates an AVL tree that allocates its nodes in blocks of blockSize
+func NewAVLTreeWithArena[K cmp.Ordered, V any](blockSize int) *AVLTree[K, V] {
+	return &AVLTree[K, V]{nodes: NewArena[avlNode[K, V]](blockSize)}
+}
+
+func (n *avlNode[K, V]) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *avlNode[K, V]) update() {
+	n.height = 1 + max(n.left.h(), n.right.h())
+}
+
+func (n *avlNode[K, V]) rotateLeft() *avlNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	n.update()
+	x.update()
+	return x
+}
+
+func (n *avlNode[K, V]) rotateRight() *avlNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	n.update()
+	x.update()
+	return x
+}
+
+func (n *avlNode[K, V]) rebalance() *avlNode[K, V] {
+	n.update()
+	switch b := n.left.h() - n.right.h(); {
+	case b > 1:
+		if n.left.left.h() < n.left.right.h() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case b < -1:
+		if n.right.right.h() < n.right.left.h() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (t *AVLTree[K, V]) find(key K) *avlNode[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (t *AVLTree[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *AVLTree[K, V]) Has(key K) bool {
+	return t.find(key) != nil
+}
+
+func (t *AVLTree[K, V]) Set(key K, value V) {
+	t.root = t.set(t.root, key, value)
+}
+
+func (t *AVLTree[K, V]) set(n *avlNode[K, V], key K, value V) *avlNode[K, V] {
+	if n == nil {
+		t.size++
+		n = t.nodes.New()
+		n.key, n.value, n.height = key, value, 1
+		return n
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = t.set(n.left, key, value)
+	case c > 0:
+		n.right = t.set(n.right, key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return n.rebalance()
+}
+
+// Delete removes key and reports whether it was present
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	size := t.size
+	t.root = t.delete(t.root, key)
+	return t.size < size
+}
+
+func (t *AVLTree[K, V]) delete(n *avlNode[K, V], key K) *avlNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = t.delete(n.left, key)
+	case c > 0:
+		n.right = t.delete(n.right, key)
+	default:
+		if n.left == nil || n.right == nil {
+			t.size--
+			child := n.left
+			if child == nil {
+				child = n.right
+			}
+			t.nodes.Free(n)
+			return child
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.key, n.value = successor.key, successor.value
+		n.right = t.delete(n.right, successor.key)
+	}
+	return n.rebalance()
+}
+
+func (t *AVLTree[K, V]) Len() int {
+	return t.size
+}
+
+func (t *AVLTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// First returns the entry with the smallest key
+func (t *AVLTree[K, V]) First() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+// Last returns the entry with the largest key
+func (t *AVLTree[K, V]) Last() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (t *AVLTree[K, V]) Floor(key K) (K, V, bool) {
+	var best *avlNode[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key
+func (t *AVLTree[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *avlNode[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			best = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// walk yields the entries of the subtree of n with keys in [lo, hi) and reports whether to continue
+func (n *avlNode[K, V]) walk(lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if lo == nil || n.key >= *lo {
+		if !n.left.walk(lo, hi, yield) {
+			return false
+		}
+		if hi != nil && n.key >= *hi {
+			return false
+		}
+		if !yield(n.key, n.value) {
+			return false
+		}
+	}
+	return n.right.walk(lo, hi, yield)
+}
+
+// All() Function returns an iterator over all entries in ascending key order
+func (t *AVLTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.walk(nil, nil, yield)
+	}
+}
+
+// Backward returns an iterator over all entries in descending key order
+func (t *AVLTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stack []*avlNode[K, V]
+		for n := t.root; n != nil || len(stack) > 0; n = n.left {
+			for ; n != nil; n = n.right {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi) in ascending order
+func (t *AVLTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.walk(&lo, &hi, yield)
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (t *AVLTree[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range t.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Marshal encodes the entries in ascending key order with codec
+func (t *AVLTree[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return marshalEntries(t.Len(), t.All(), codec)
+}
+
+// Unmarshal replaces the contents of t with data produced by Marshal using the same codec
+func (t *AVLTree[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	t.root, t.size = nil, 0
+	t.nodes.Reset()
+	for _, e := range entries {
+		t.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// Validate checks the search order, the cached heights and balance factors, and the cached size
+func (t *AVLTree[K, V]) Validate() error {
+	n, err := t.root.validate(nil, nil)
+	if err == nil && n != t.size {
+		err = invariantf("size %d, but %d nodes", t.size, n)
+	}
+	return err
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (t *AVLTree[K, V]) CheckInvariants() {
+	checkInvariants(t)
+}
+
+// validate returns the number of nodes in the subtree rooted at n
+func (n *avlNode[K, V]) validate(lo, hi *K) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+		return 0, invariantf("key %v out of order", n.key)
+	}
+	lc, err := n.left.validate(lo, &n.key)
+	if err != nil {
+		return 0, err
+	}
+	rc, err := n.right.validate(&n.key, hi)
+	if err != nil {
+		return 0, err
+	}
+	if want := 1 + max(n.left.h(), n.right.h()); n.height != want {
+		return 0, invariantf("height %d at %v, want %d", n.height, n.key, want)
+	}
+	if d := n.left.h() - n.right.h(); d < -1 || d > 1 {
+		return 0, invariantf("balance factor %d at %v", d, n.key)
+	}
+	return lc + rc + 1, nil
+}
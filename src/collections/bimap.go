@@ -0,0 +1,92 @@
+package collections
+
+import (
+	"errors"
+	"iter"
+	"maps"
+)
+
+// ErrValueExists is returned by BiMap.Put when the value is already mapped to a different key
+var ErrValueExists = errors.New("bimap: value already mapped to another key")
+
+// BiMap is a one-to-one map that can be looked up by key and by value
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), backward: make(map[V]K)}
+}
+
+// Put maps key to value, replacing the previous value of key.
+// It fails with ErrValueExists if value is already mapped to a different key.
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if k, ok := b.backward[value]; ok && k != key {
+		return ErrValueExists
+	}
+	b.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut maps key to value, removing any existing mapping of key or of value
+func (b *BiMap[K, V]) ForcePut(key K, value V) {
+	if v, ok := b.forward[key]; ok {
+		delete(b.backward, v)
+	}
+	if k, ok := b.backward[value]; ok {
+		delete(b.forward, k)
+	}
+	b.forward[key] = value
+	b.backward[value] = key
+}
+
+func (b *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := b.forward[key]
+	return v, ok
+}
+
+// GetKey returns the key mapped to value
+func (b *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := b.backward[value]
+	return k, ok
+}
+
+// Delete removes key and its value and reports whether key was present
+func (b *BiMap[K, V]) Delete(key K) bool {
+	v, ok := b.forward[key]
+	if ok {
+		delete(b.forward, key)
+		delete(b.backward, v)
+	}
+	return ok
+}
+
+// DeleteValue removes value and its key and reports whether value was present
+func (b *BiMap[K, V]) DeleteValue(value V) bool {
+	k, ok := b.backward[value]
+	if ok {
+		delete(b.backward, value)
+		delete(b.forward, k)
+	}
+	return ok
+}
+
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// Inverse returns a view of the map with keys and values swapped, sharing the same storage
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: b.backward, backward: b.forward}
+}
+
+// All() Function returns an iterator over all key-value pairs
+func (b *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(b.forward)
+}
+
+// ByValue returns an iterator over all value-key pairs
+func (b *BiMap[K, V]) ByValue() iter.Seq2[V, K] {
+	return maps.All(b.backward)
+}
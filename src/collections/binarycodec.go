@@ -0,0 +1,125 @@
+package collections
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var ErrUnsupportedType = errors.New("type has no binary encoding")
+
+// ElementCodec is a Codec built from a pair of functions
+type ElementCodec[T any] struct {
+	Marshal   func(T) ([]byte, error)
+	Unmarshal func([]byte) (T, error)
+}
+
+func (c ElementCodec[T]) Encode(v T) ([]byte, error) {
+	return c.Marshal(v)
+}
+
+func (c ElementCodec[T]) Decode(data []byte) (T, error) {
+	return c.Unmarshal(data)
+}
+
+// DefaultElementCodec handles strings, byte slices, ints as varints, types implementing encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler, and fixed-size values supported by encoding/binary. Other types fail with
+// ErrUnsupportedType.
+func DefaultElementCodec[T any]() ElementCodec[T] {
+	var zero T
+	switch any(zero).(type) {
+	case string:
+		return ElementCodec[T]{
+			Marshal:   func(v T) ([]byte, error) { return []byte(any(v).(string)), nil },
+			Unmarshal: func(b []byte) (T, error) { return any(string(b)).(T), nil },
+		}
+	case int:
+		return ElementCodec[T]{
+			Marshal: func(v T) ([]byte, error) { return binary.AppendVarint(nil, int64(any(v).(int))), nil },
+			Unmarshal: func(b []byte) (T, error) {
+				n, k := binary.Varint(b)
+				if k <= 0 || k != len(b) {
+					return zero, ErrInvalidData
+				}
+				return any(int(n)).(T), nil
+			},
+		}
+	case []byte:
+		return ElementCodec[T]{
+			Marshal:   func(v T) ([]byte, error) { return any(v).([]byte), nil },
+			Unmarshal: func(b []byte) (T, error) { return any([]byte(string(b))).(T), nil },
+		}
+	}
+	if _, ok := any(&zero).(encoding.BinaryUnmarshaler); ok {
+		if _, ok := any(zero).(encoding.BinaryMarshaler); ok {
+			return ElementCodec[T]{
+				Marshal: func(v T) ([]byte, error) { return any(v).(encoding.BinaryMarshaler).MarshalBinary() },
+				Unmarshal: func(b []byte) (T, error) {
+					var v T
+					err := any(&v).(encoding.BinaryUnmarshaler).UnmarshalBinary(b)
+					return v, err
+				},
+			}
+		}
+	}
+	if binary.Size(zero) < 0 {
+		unsupported := fmt.Errorf("%w: %T", ErrUnsupportedType, zero)
+		return ElementCodec[T]{
+			Marshal:   func(T) ([]byte, error) { return nil, unsupported },
+			Unmarshal: func([]byte) (T, error) { return zero, unsupported },
+		}
+	}
+	return ElementCodec[T]{
+		Marshal: func(v T) ([]byte, error) { return binary.Append(nil, binary.LittleEndian, v) },
+		Unmarshal: func(b []byte) (T, error) {
+			var v T
+			n, err := binary.Decode(b, binary.LittleEndian, &v)
+			if err == nil && n != len(b) {
+				err = ErrInvalidData
+			}
+			return v, err
+		},
+	}
+}
+
+// appendElements writes the element count followed by every element prefixed with its length, all as uvarints
+func appendElements[T any](buf []byte, n int, seq iter.Seq[T], codec Codec[T]) ([]byte, error) {
+	buf = binary.AppendUvarint(buf, uint64(n))
+	for v := range seq {
+		b, err := codec.Encode(v)
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		buf = append(buf, b...)
+	}
+	return buf, nil
+}
+
+// decodeElements reads data written by appendElements
+func decodeElements[T any](data []byte, codec Codec[T]) ([]T, error) {
+	n, k := binary.Uvarint(data)
+	if k <= 0 || n > uint64(len(data)) {
+		return nil, ErrInvalidData
+	}
+	data = data[k:]
+	s := make([]T, 0, n)
+	for range n {
+		size, k := binary.Uvarint(data)
+		if k <= 0 || size > uint64(len(data)-k) {
+			return nil, ErrInvalidData
+		}
+		v, err := codec.Decode(data[k : k+int(size)])
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, v)
+		data = data[k+int(size):]
+	}
+	if len(data) != 0 {
+		return nil, ErrInvalidData
+	}
+	return s, nil
+}
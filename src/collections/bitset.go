@@ -0,0 +1,121 @@
+package collections
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// BitSet is a compact set of non-negative integers that grows as needed
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates a bit set with room for n bits before it has to grow
+func NewBitSet(n int) *BitSet {
+	return &BitSet{words: make([]uint64, (max(n, 0)+63)/64)}
+}
+
+func (b *BitSet) grow(words int) {
+	if words > len(b.words) {
+		b.words = append(b.words, make([]uint64, words-len(b.words))...)
+	}
+}
+
+// Set adds i to the set. It panics if i is negative.
+func (b *BitSet) Set(i int) {
+	if i < 0 {
+		panic("bitset: negative index")
+	}
+	b.grow(i/64 + 1)
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+func (b *BitSet) Clear(i int) {
+	if i >= 0 && i/64 < len(b.words) {
+		b.words[i/64] &^= 1 << (i % 64)
+	}
+}
+
+func (b *BitSet) Test(i int) bool {
+	return i >= 0 && i/64 < len(b.words) && b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// And keeps only the bits that are also set in other
+func (b *BitSet) And(other *BitSet) {
+	for i := range b.words {
+		if i < len(other.words) {
+			b.words[i] &= other.words[i]
+		} else {
+			b.words[i] = 0
+		}
+	}
+}
+
+// Or adds all bits set in other
+func (b *BitSet) Or(other *BitSet) {
+	b.grow(len(other.words))
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+}
+
+// Xor toggles all bits set in other
+func (b *BitSet) Xor(other *BitSet) {
+	b.grow(len(other.words))
+	for i, w := range other.words {
+		b.words[i] ^= w
+	}
+}
+
+// AndNot removes all bits set in other
+func (b *BitSet) AndNot(other *BitSet) {
+	for i := range min(len(b.words), len(other.words)) {
+		b.words[i] &^= other.words[i]
+	}
+}
+
+// Count returns the number of set bits
+func (b *BitSet) Count() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// NextSet returns the smallest set bit that is >= i
+func (b *BitSet) NextSet(i int) (int, bool) {
+	i = max(i, 0)
+	idx := i / 64
+	if idx >= len(b.words) {
+		return 0, false
+	}
+	w := b.words[idx] >> (i % 64)
+	if w != 0 {
+		return i + bits.TrailingZeros64(w), true
+	}
+	for idx++; idx < len(b.words); idx++ {
+		if b.words[idx] != 0 {
+			return idx*64 + bits.TrailingZeros64(b.words[idx]), true
+		}
+	}
+	return 0, false
+}
+
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// All returns an iterator over the set bits in ascending order
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for idx, w := range b.words {
+			for w != 0 {
+				if !yield(idx*64 + bits.TrailingZeros64(w)) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package collections
+
+import "iter"
+
+const dequeBlockSize = 128
+
+// BlockDeque is a double-ended queue that stores its elements in fixed-size blocks. Growing never copies
+// elements, and blocks are released as soon as they are emptied, so memory follows the current size.
+// It has the same API as Deque.
+type BlockDeque[T any] struct {
+	// blocks only grows by copying block pointers
+	blocks Deque[*[dequeBlockSize]T]
+	// head is the position of the first element in the first block
+	head int
+	size int
+}
+
+func NewBlockDeque[T any]() *BlockDeque[T] {
+	return &BlockDeque[T]{}
+}
+
+// slot returns the block and offset of the i-th element
+func (d *BlockDeque[T]) slot(i int) (*[dequeBlockSize]T, int) {
+	i += d.head
+	return d.blocks.At(i / dequeBlockSize), i % dequeBlockSize
+}
+
+func (d *BlockDeque[T]) PushFront(value T) {
+	if d.head == 0 {
+		d.blocks.PushFront(new([dequeBlockSize]T))
+		d.head = dequeBlockSize
+	}
+	d.head--
+	d.size++
+	b, i := d.slot(0)
+	b[i] = value
+}
+
+func (d *BlockDeque[T]) PushBack(value T) {
+	if d.head+d.size == d.blocks.Len()*dequeBlockSize {
+		d.blocks.PushBack(new([dequeBlockSize]T))
+	}
+	d.size++
+	b, i := d.slot(d.size - 1)
+	b[i] = value
+}
+
+func (d *BlockDeque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	b, i := d.slot(0)
+	val := b[i]
+	b[i] = zero
+	d.head++
+	d.size--
+	if d.head == dequeBlockSize || d.size == 0 {
+		d.releaseFront()
+	}
+	return val, true
+}
+
+func (d *BlockDeque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	b, i := d.slot(d.size - 1)
+	val := b[i]
+	b[i] = zero
+	d.size--
+	if i == 0 || d.size == 0 {
+		d.blocks.PopBack()
+		if d.size == 0 {
+			d.releaseFront()
+		}
+	}
+	return val, true
+}
+
+// releaseFront drops the first block once it holds no more elements
+func (d *BlockDeque[T]) releaseFront() {
+	if d.size == 0 {
+		for !d.blocks.IsEmpty() {
+			d.blocks.PopBack()
+		}
+		d.head = 0
+		return
+	}
+	d.blocks.PopFront()
+	d.head = 0
+}
+
+func (d *BlockDeque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.At(0), true
+}
+
+func (d *BlockDeque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.At(d.size - 1), true
+}
+
+// At returns the i-th element counted from the front
+func (d *BlockDeque[T]) At(i int) T {
+	if i < 0 || i >= d.size {
+		panic("deque: index out of range")
+	}
+	b, j := d.slot(i)
+	return b[j]
+}
+
+func (d *BlockDeque[T]) Len() int {
+	return d.size
+}
+
+func (d *BlockDeque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// All returns an iterator over all elements from front to back
+func (d *BlockDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.size {
+			if !yield(d.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all elements from back to front
+func (d *BlockDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.At(i)) {
+				return
+			}
+		}
+	}
+}
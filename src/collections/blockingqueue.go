@@ -0,0 +1,77 @@
+package collections
+
+import (
+	"context"
+	"time"
+)
+
+// BlockingQueue is a bounded FIFO queue where Put blocks while it is full and Take blocks while it is empty
+type BlockingQueue[T any] struct {
+	ch chan T
+}
+
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	return &BlockingQueue[T]{ch: make(chan T, max(capacity, 1))}
+}
+
+// Put adds value, waiting for free space until ctx is canceled
+func (q *BlockingQueue[T]) Put(ctx context.Context, value T) error {
+	select {
+	case q.ch <- value:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Take removes the oldest value, waiting for one to arrive until ctx is canceled
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	select {
+	case v := <-q.ch:
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+func (q *BlockingQueue[T]) PutTimeout(value T, d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return q.Put(ctx, value)
+}
+
+func (q *BlockingQueue[T]) TakeTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return q.Take(ctx)
+}
+
+// TryPut adds value without blocking and reports false if the queue is full
+func (q *BlockingQueue[T]) TryPut(value T) bool {
+	select {
+	case q.ch <- value:
+		return true
+	default:
+		return false
+	}
+}
+
+// TryTake removes the oldest value without blocking and reports false if the queue is empty
+func (q *BlockingQueue[T]) TryTake() (T, bool) {
+	select {
+	case v := <-q.ch:
+		return v, true
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+func (q *BlockingQueue[T]) Len() int {
+	return len(q.ch)
+}
+
+func (q *BlockingQueue[T]) Cap() int {
+	return cap(q.ch)
+}
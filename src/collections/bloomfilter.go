@@ -0,0 +1,127 @@
+package collections
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	// ErrIncompatible is returned when combining filters or sketches created with different parameters
+	ErrIncompatible = errors.New("incompatible parameters")
+	// ErrInvalidData is returned when decoding malformed binary data
+	ErrInvalidData = errors.New("invalid encoded data")
+)
+
+// BloomFilter is a probabilistic set that may report false positives but never false negatives
+type BloomFilter[T any] struct {
+	bits  []uint64
+	m     uint64
+	k     uint64
+	hash  Hasher[T]
+	added uint64
+}
+
+// NewBloomFilter creates a filter sized for n elements at the given false-positive rate
+func NewBloomFilter[T any](n int, fpRate float64, hash Hasher[T]) *BloomFilter[T] {
+	n = max(n, 1)
+	fpRate = min(max(fpRate, 1e-12), 0.5)
+	m := uint64(math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	return newBloomFilter(max(m, 64), max(k, 1), hash)
+}
+
+func newBloomFilter[T any](m, k uint64, hash Hasher[T]) *BloomFilter[T] {
+	return &BloomFilter[T]{bits: make([]uint64, (m+63)/64), m: m, k: k, hash: hash}
+}
+
+// positions derives the k bit positions of value by double hashing
+func (f *BloomFilter[T]) positions(value T, do func(uint64) bool) bool {
+	h1 := f.hash(value)
+	h2 := mix64(h1) | 1
+	for i := range f.k {
+		if !do((h1 + i*h2) % f.m) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *BloomFilter[T]) Add(value T) {
+	f.positions(value, func(p uint64) bool {
+		f.bits[p/64] |= 1 << (p % 64)
+		return true
+	})
+	f.added++
+}
+
+// MaybeContains reports false if value was certainly never added, and true if it probably was
+func (f *BloomFilter[T]) MaybeContains(value T) bool {
+	return f.positions(value, func(p uint64) bool {
+		return f.bits[p/64]&(1<<(p%64)) != 0
+	})
+}
+
+// Union adds all elements of other to f. Both filters must have the same size and number of hashes.
+func (f *BloomFilter[T]) Union(other *BloomFilter[T]) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrIncompatible
+	}
+	for i, w := range other.bits {
+		f.bits[i] |= w
+	}
+	f.added += other.added
+	return nil
+}
+
+// EstimatedFPRate returns the false-positive rate expected for the current fill level
+func (f *BloomFilter[T]) EstimatedFPRate() float64 {
+	set := 0
+	for _, w := range f.bits {
+		set += bits.OnesCount64(w)
+	}
+	return math.Pow(float64(set)/float64(f.m), float64(f.k))
+}
+
+// MarshalBinary encodes the filter as m, k and the number of added elements followed by the bit words.
+// The hash function is not encoded and has to be supplied again when decoding.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 24+8*len(f.bits))
+	buf = binary.BigEndian.AppendUint64(buf, f.m)
+	buf = binary.BigEndian.AppendUint64(buf, f.k)
+	buf = binary.BigEndian.AppendUint64(buf, f.added)
+	for _, w := range f.bits {
+		buf = binary.BigEndian.AppendUint64(buf, w)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the contents of f with data produced by MarshalBinary, keeping the hash function of f
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 24 {
+		return ErrInvalidData
+	}
+	m := binary.BigEndian.Uint64(data)
+	k := binary.BigEndian.Uint64(data[8:])
+	// (m+63)/64 would overflow for m close to 2^64
+	words := m / 64
+	if m%64 != 0 {
+		words++
+	}
+	if m == 0 || k == 0 || (len(data)-24)%8 != 0 || words != uint64(len(data)-24)/8 {
+		return ErrInvalidData
+	}
+	f.m, f.k = m, k
+	f.added = binary.BigEndian.Uint64(data[16:])
+	f.bits = make([]uint64, words)
+	for i := range f.bits {
+		f.bits[i] = binary.BigEndian.Uint64(data[24+8*i:])
+	}
+	return nil
+}
+
+// Len returns the number of Add calls, counting duplicates
+func (f *BloomFilter[T]) Len() int {
+	return int(f.added)
+}
@@ -0,0 +1,48 @@
+package collections
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestBloomFilterRoundTrip(t *testing.T) {
+	f := NewBloomFilter(100, 0.01, StringHasher)
+	f.Add("a")
+	f.Add("b")
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewBloomFilter(1, 0.5, StringHasher)
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !g.MaybeContains("a") || !g.MaybeContains("b") || g.Len() != 2 {
+		t.Error("decoded filter lost its elements")
+	}
+}
+
+func TestBloomFilterUnmarshalInvalid(t *testing.T) {
+	header := func(m, k uint64, words int) []byte {
+		data := binary.BigEndian.AppendUint64(nil, m)
+		data = binary.BigEndian.AppendUint64(data, k)
+		data = binary.BigEndian.AppendUint64(data, 0)
+		return append(data, make([]byte, 8*words)...)
+	}
+	tests := map[string][]byte{
+		"short":          make([]byte, 23),
+		"zero bits":      header(0, 3, 0),
+		"zero hashes":    header(64, 0, 1),
+		"overflowing m":  header(1<<64-1, 3, 0),
+		"missing words":  header(65, 3, 1),
+		"trailing bytes": append(header(64, 3, 1), 0),
+		"too many words": header(64, 3, 2),
+	}
+	for name, data := range tests {
+		f := NewBloomFilter(10, 0.1, StringHasher)
+		if err := f.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("%s: UnmarshalBinary() = %v, want %v", name, err, ErrInvalidData)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package collections
+
+import (
+	"iter"
+	"sync"
+)
+
+// OverflowPolicy decides what happens when a value arrives at a full buffer
+type OverflowPolicy int
+
+const (
+	// Block waits until there is room in the buffer
+	Block OverflowPolicy = iota
+	// DropOldest discards the oldest buffered value to make room
+	DropOldest
+	// DropIncoming discards the arriving value
+	DropIncoming
+)
+
+// Broadcast delivers every published value to all current subscribers
+type Broadcast[T any] struct {
+	mu     sync.RWMutex
+	subs   map[*subscriber[T]]struct{}
+	closed bool
+}
+
+type subscriber[T any] struct {
+	ch       chan T
+	policy   OverflowPolicy
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func NewBroadcast[T any]() *Broadcast[T] {
+	return &Broadcast[T]{subs: make(map[*subscriber[T]]struct{})}
+}
+
+// Publish delivers value to every subscriber according to its overflow policy
+func (b *Broadcast[T]) Publish(value T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+	for s := range b.subs {
+		s.deliver(value)
+	}
+}
+
+func (s *subscriber[T]) deliver(value T) {
+	select {
+	case s.ch <- value:
+		return
+	default:
+	}
+	switch s.policy {
+	case Block:
+		select {
+		case s.ch <- value:
+		case <-s.done:
+		}
+	case DropOldest:
+		// The retry is non-blocking, so a value is dropped if a concurrent publisher took the freed slot
+		select {
+		case <-s.ch:
+		default:
+		}
+		select {
+		case s.ch <- value:
+		default:
+		}
+	}
+}
+
+// Subscribe registers a new subscriber buffering up to buf values and returns an iterator over the values
+// published from now on. The iterator may only be ranged over once; stopping it unsubscribes.
+func (b *Broadcast[T]) Subscribe(buf int, policy OverflowPolicy) iter.Seq[T] {
+	s := &subscriber[T]{
+		ch:     make(chan T, max(buf, 0)),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	b.mu.Lock()
+	if b.closed {
+		close(s.ch)
+	} else {
+		b.subs[s] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	return func(yield func(T) bool) {
+		defer b.unsubscribe(s)
+		for v := range s.ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (b *Broadcast[T]) unsubscribe(s *subscriber[T]) {
+	// Release a publisher blocked on this subscriber before taking the lock
+	s.doneOnce.Do(func() { close(s.done) })
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[s]; ok {
+		delete(b.subs, s)
+		close(s.ch)
+	}
+}
+
+// Close ends all subscriptions once their buffered values are consumed
+func (b *Broadcast[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for s := range b.subs {
+		close(s.ch)
+	}
+	clear(b.subs)
+}
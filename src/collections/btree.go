@@ -0,0 +1,483 @@
+package collections
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"slices"
+)
+
+// ErrUnsorted is returned when an input that must be strictly ascending is not
+var ErrUnsorted = errors.New("keys are not strictly ascending")
+
+// BTreeMap is a sorted map backed by a B-tree, storing many keys per node for cache-friendly lookups
+type BTreeMap[K cmp.Ordered, V any] struct {
+	root *btreeNode[K, V]
+	// degree is the minimum degree t: every node except the root holds between t-1 and 2t-1 keys
+	degree int
+	size   int
+}
+
+type btreeNode[K cmp.Ordered, V any] struct {
+	keys     []K
+	values   []V
+	children []*btreeNode[K, V]
+}
+
+func (n *btreeNode[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// NewBTreeMap creates a B-tree with the given minimum degree, which is raised to at least 2
+func NewBTreeMap[K cmp.Ordered, V any](degree int) *BTreeMap[K, V] {
+	return &BTreeMap[K, V]{root: &btreeNode[K, V]{}, degree: max(degree, 2)}
+}
+
+// BTreeFromSorted bulk-loads a B-tree in O(n) from entries in strictly ascending key order, packing nodes densely
+func BTreeFromSorted[K cmp.Ordered, V any](degree int, seq iter.Seq2[K, V]) (*BTreeMap[K, V], error) {
+	m := NewBTreeMap[K, V](degree)
+	var (
+		keys   []K
+		values []V
+	)
+	for k, v := range seq {
+		if len(keys) > 0 && k <= keys[len(keys)-1] {
+			return nil, ErrUnsorted
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if len(keys) == 0 {
+		return m, nil
+	}
+
+	// capacities[h] is the maximum number of keys in a tree of height h
+	capacities := []int{2*m.degree - 1}
+	for capacities[len(capacities)-1] < len(keys) {
+		c := capacities[len(capacities)-1]
+		capacities = append(capacities, (c+1)*2*m.degree-1)
+	}
+	m.root = buildBTree(keys, values, capacities, len(capacities)-1)
+	m.size = len(keys)
+	return m, nil
+}
+
+// buildBTree builds a tree of height h from more than capacities[h-1] keys,
+// splitting them evenly so that every child is at least half full
+func buildBTree[K cmp.Ordered, V any](keys []K, values []V, capacities []int, h int) *btreeNode[K, V] {
+	if h == 0 {
+		return &btreeNode[K, V]{keys: slices.Clone(keys), values: slices.Clone(values)}
+	}
+	childCap := capacities[h-1]
+	c := (len(keys) + 1 + childCap) / (childCap + 1)
+	inChildren := len(keys) - (c - 1)
+	base, extra := inChildren/c, inChildren%c
+
+	n := &btreeNode[K, V]{}
+	pos := 0
+	for i := range c {
+		size := base
+		if i < extra {
+			size++
+		}
+		n.children = append(n.children, buildBTree(keys[pos:pos+size], values[pos:pos+size], capacities, h-1))
+		pos += size
+		if i < c-1 {
+			n.keys = append(n.keys, keys[pos])
+			n.values = append(n.values, values[pos])
+			pos++
+		}
+	}
+	return n
+}
+
+func (m *BTreeMap[K, V]) Get(key K) (V, bool) {
+	for n := m.root; ; {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			return n.values[i], true
+		}
+		if n.leaf() {
+			var zero V
+			return zero, false
+		}
+		n = n.children[i]
+	}
+}
+
+func (m *BTreeMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+func (m *BTreeMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *BTreeMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *BTreeMap[K, V]) full(n *btreeNode[K, V]) bool {
+	return len(n.keys) == 2*m.degree-1
+}
+
+// splitChild splits the full child i of n, moving its median key up into n
+func (m *BTreeMap[K, V]) splitChild(n *btreeNode[K, V], i int) {
+	t := m.degree
+	child := n.children[i]
+	right := &btreeNode[K, V]{
+		keys:   slices.Clone(child.keys[t:]),
+		values: slices.Clone(child.values[t:]),
+	}
+	if !child.leaf() {
+		right.children = slices.Clone(child.children[t:])
+		clear(child.children[t:])
+		child.children = child.children[:t]
+	}
+	n.keys = slices.Insert(n.keys, i, child.keys[t-1])
+	n.values = slices.Insert(n.values, i, child.values[t-1])
+	n.children = slices.Insert(n.children, i+1, right)
+	clear(child.values[t-1:])
+	child.keys = child.keys[:t-1]
+	child.values = child.values[:t-1]
+}
+
+func (m *BTreeMap[K, V]) Set(key K, value V) {
+	if m.full(m.root) {
+		m.root = &btreeNode[K, V]{children: []*btreeNode[K, V]{m.root}}
+		m.splitChild(m.root, 0)
+	}
+	n := m.root
+	for {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			n.values[i] = value
+			return
+		}
+		if n.leaf() {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			m.size++
+			return
+		}
+		if m.full(n.children[i]) {
+			m.splitChild(n, i)
+			switch {
+			case key == n.keys[i]:
+				n.values[i] = value
+				return
+			case key > n.keys[i]:
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// Delete removes key and reports whether it was present
+func (m *BTreeMap[K, V]) Delete(key K) bool {
+	if !m.Has(key) {
+		return false
+	}
+	m.delete(m.root, key)
+	if len(m.root.keys) == 0 && !m.root.leaf() {
+		m.root = m.root.children[0]
+	}
+	m.size--
+	return true
+}
+
+// delete removes key, which must be present, from the subtree of n.
+// Every node it descends into has at least t keys, so removing one never underflows.
+func (m *BTreeMap[K, V]) delete(n *btreeNode[K, V], key K) {
+	t := m.degree
+	for {
+		i, found := slices.BinarySearch(n.keys, key)
+		if n.leaf() {
+			n.keys = slices.Delete(n.keys, i, i+1)
+			n.values = slices.Delete(n.values, i, i+1)
+			return
+		}
+		if found {
+			switch {
+			case len(n.children[i].keys) >= t:
+				// Replace by the predecessor and delete that instead
+				p := n.children[i]
+				for !p.leaf() {
+					p = p.children[len(p.children)-1]
+				}
+				key = p.keys[len(p.keys)-1]
+				n.keys[i], n.values[i] = key, p.values[len(p.values)-1]
+				n = n.children[i]
+			case len(n.children[i+1].keys) >= t:
+				// Replace by the successor and delete that instead
+				s := n.children[i+1]
+				for !s.leaf() {
+					s = s.children[0]
+				}
+				key = s.keys[0]
+				n.keys[i], n.values[i] = key, s.values[0]
+				n = n.children[i+1]
+			default:
+				m.merge(n, i)
+				n = n.children[i]
+			}
+			continue
+		}
+		if len(n.children[i].keys) < t {
+			i = m.fill(n, i)
+		}
+		n = n.children[i]
+	}
+}
+
+// fill makes sure child i of n has at least t keys and returns the index of the child that now covers its range
+func (m *BTreeMap[K, V]) fill(n *btreeNode[K, V], i int) int {
+	t := m.degree
+	child := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= t:
+		// Rotate a key from the left sibling through n
+		left := n.children[i-1]
+		last := len(left.keys) - 1
+		child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+		child.values = slices.Insert(child.values, 0, n.values[i-1])
+		n.keys[i-1], n.values[i-1] = left.keys[last], left.values[last]
+		// Clear the vacated slots, which would otherwise keep the entry reachable after it is deleted
+		// from its new node; slices.Delete clears them in the other cases
+		clear(left.keys[last:])
+		clear(left.values[last:])
+		left.keys, left.values = left.keys[:last], left.values[:last]
+		if !left.leaf() {
+			child.children = slices.Insert(child.children, 0, left.children[last+1])
+			clear(left.children[last+1:])
+			left.children = left.children[:last+1]
+		}
+	case i < len(n.children)-1 && len(n.children[i+1].keys) >= t:
+		// Rotate a key from the right sibling through n
+		right := n.children[i+1]
+		child.keys = append(child.keys, n.keys[i])
+		child.values = append(child.values, n.values[i])
+		n.keys[i], n.values[i] = right.keys[0], right.values[0]
+		right.keys = slices.Delete(right.keys, 0, 1)
+		right.values = slices.Delete(right.values, 0, 1)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	case i < len(n.children)-1:
+		m.merge(n, i)
+	default:
+		m.merge(n, i-1)
+		return i - 1
+	}
+	return i
+}
+
+// merge joins child i, key i and child i+1 of n into child i
+func (m *BTreeMap[K, V]) merge(n *btreeNode[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+	left.values = append(append(left.values, n.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.values = slices.Delete(n.values, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// First returns the entry with the smallest key
+func (m *BTreeMap[K, V]) First() (K, V, bool) {
+	if m.size == 0 {
+		return zeroEntry[K, V]()
+	}
+	n := m.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.keys[0], n.values[0], true
+}
+
+// Last returns the entry with the largest key
+func (m *BTreeMap[K, V]) Last() (K, V, bool) {
+	if m.size == 0 {
+		return zeroEntry[K, V]()
+	}
+	n := m.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.keys[len(n.keys)-1], n.values[len(n.values)-1], true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (m *BTreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var (
+		best  *btreeNode[K, V]
+		bestI int
+	)
+	for n := m.root; ; {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			return n.keys[i], n.values[i], true
+		}
+		if i > 0 {
+			best, bestI = n, i-1
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.keys[bestI], best.values[bestI], true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key
+func (m *BTreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var (
+		best  *btreeNode[K, V]
+		bestI int
+	)
+	for n := m.root; ; {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			return n.keys[i], n.values[i], true
+		}
+		if i < len(n.keys) {
+			best, bestI = n, i
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.keys[bestI], best.values[bestI], true
+}
+
+// walk yields the entries of the subtree of n with keys in [lo, hi) and reports whether to continue
+func (n *btreeNode[K, V]) walk(lo, hi *K, yield func(K, V) bool) bool {
+	i := 0
+	if lo != nil {
+		i, _ = slices.BinarySearch(n.keys, *lo)
+	}
+	for ; i <= len(n.keys); i++ {
+		if !n.leaf() && !n.children[i].walk(lo, hi, yield) {
+			return false
+		}
+		if i == len(n.keys) {
+			break
+		}
+		if hi != nil && n.keys[i] >= *hi {
+			return false
+		}
+		if !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// All() Function returns an iterator over all entries in ascending key order
+func (m *BTreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.walk(nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi) in ascending order
+func (m *BTreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.walk(&lo, &hi, yield)
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (m *BTreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Marshal encodes the entries in ascending key order with codec
+func (m *BTreeMap[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return marshalEntries(m.Len(), m.All(), codec)
+}
+
+// Unmarshal replaces the contents of m with data produced by Marshal using the same codec
+func (m *BTreeMap[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	m.root, m.degree, m.size = &btreeNode[K, V]{}, max(m.degree, 2), 0
+	for _, e := range entries {
+		m.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// Validate checks the key order, the per-node key and child counts, that all leaves have the same depth,
+// and the cached size
+func (m *BTreeMap[K, V]) Validate() error {
+	n, _, err := m.validate(m.root, nil, nil, true)
+	if err == nil && n != m.size {
+		err = invariantf("size %d, but %d keys", m.size, n)
+	}
+	return err
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (m *BTreeMap[K, V]) CheckInvariants() {
+	checkInvariants(m)
+}
+
+// validate returns the number of keys and the height of the subtree rooted at n
+func (m *BTreeMap[K, V]) validate(n *btreeNode[K, V], lo, hi *K, root bool) (count, height int, err error) {
+	if len(n.values) != len(n.keys) {
+		return 0, 0, invariantf("node with %d keys holds %d values", len(n.keys), len(n.values))
+	}
+	if len(n.keys) > 2*m.degree-1 || (!root && len(n.keys) < m.degree-1) {
+		return 0, 0, invariantf("node holds %d keys with degree %d", len(n.keys), m.degree)
+	}
+	if err := checkSorted(n.keys); err != nil {
+		return 0, 0, err
+	}
+	if len(n.keys) > 0 && ((lo != nil && n.keys[0] <= *lo) || (hi != nil && n.keys[len(n.keys)-1] >= *hi)) {
+		return 0, 0, invariantf("keys %v outside their parent's bounds", n.keys)
+	}
+	if n.leaf() {
+		return len(n.keys), 1, nil
+	}
+	if len(n.children) != len(n.keys)+1 {
+		return 0, 0, invariantf("node with %d keys has %d children", len(n.keys), len(n.children))
+	}
+	count = len(n.keys)
+	for i, c := range n.children {
+		clo, chi := lo, hi
+		if i > 0 {
+			clo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			chi = &n.keys[i]
+		}
+		cc, ch, err := m.validate(c, clo, chi, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		if i > 0 && ch != height {
+			return 0, 0, invariantf("leaves at different depths below %v", n.keys)
+		}
+		count, height = count+cc, ch
+	}
+	return count, height + 1, nil
+}
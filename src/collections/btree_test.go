@@ -0,0 +1,33 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// TestBTreeMapDeleteClearsSlots checks that no node keeps a removed value in the spare capacity of its
+// value slice, where it would stay reachable
+func TestBTreeMapDeleteClearsSlots(t *testing.T) {
+	m := NewBTreeMap[int, *int](2)
+	for i := range 200 {
+		m.Set(i, &i)
+	}
+	// a random order exercises borrowing from both siblings and merging
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, i := range rng.Perm(200)[:150] {
+		m.Delete(i)
+	}
+	var walk func(n *btreeNode[int, *int])
+	walk = func(n *btreeNode[int, *int]) {
+		spare := n.values[len(n.values):cap(n.values)]
+		if slices.ContainsFunc(spare, func(v *int) bool { return v != nil }) {
+			t.Errorf("node %v keeps removed values", n.keys)
+		}
+		for _, c := range n.children {
+			walk(c)
+		}
+	}
+	walk(m.root)
+	m.CheckInvariants()
+}
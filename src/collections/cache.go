@@ -0,0 +1,28 @@
+package collections
+
+import "iter"
+
+// CacheStats counts the lookups of a cache
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// Cache is the common interface of the fixed-capacity caches, so eviction policies can be swapped for one another
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	Put(key K, value V)
+	Remove(key K) bool
+	Len() int
+	Cap() int
+	Stats() CacheStats
+	OnEvict(fn func(key K, value V))
+	All() iter.Seq2[K, V]
+}
+
+var (
+	_ Cache[int, int] = (*LRU[int, int])(nil)
+	_ Cache[int, int] = (*LFU[int, int])(nil)
+	_ Cache[int, int] = (*ARC[int, int])(nil)
+)
@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+const cacheBenchCapacity = 1000
+
+// cacheTraces returns synthetic key traces: a skewed one and the same with interleaved sequential scans
+func cacheTraces(n int) map[string][]uint64 {
+	r := rand.New(rand.NewPCG(1, 2))
+	zipf := rand.NewZipf(r, 1.1, 1, 10_000)
+	skewed := make([]uint64, n)
+	for i := range skewed {
+		skewed[i] = zipf.Uint64()
+	}
+	scan := make([]uint64, n)
+	next := uint64(100_000)
+	for i := range scan {
+		if i%1000 < 300 {
+			scan[i] = next
+			next++
+		} else {
+			scan[i] = zipf.Uint64()
+		}
+	}
+	return map[string][]uint64{"zipf": skewed, "zipf+scan": scan}
+}
+
+var benchTraces = cacheTraces(200_000)
+
+// benchmarkCache replays every trace against a fresh cache and reports the hit ratio next to the cost per access
+func benchmarkCache(b *testing.B, newCache func() Cache[uint64, uint64]) {
+	for _, name := range []string{"zipf", "zipf+scan"} {
+		trace := benchTraces[name]
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			c := newCache()
+			for i := range b.N {
+				k := trace[i%len(trace)]
+				if _, ok := c.Get(k); !ok {
+					c.Put(k, k)
+				}
+			}
+			stats := c.Stats()
+			b.ReportMetric(float64(stats.Hits)/float64(max(stats.Hits+stats.Misses, 1)), "hit-ratio")
+		})
+	}
+}
+
+func BenchmarkLRU(b *testing.B) {
+	benchmarkCache(b, func() Cache[uint64, uint64] { return NewLRU[uint64, uint64](cacheBenchCapacity) })
+}
+
+func BenchmarkLFU(b *testing.B) {
+	benchmarkCache(b, func() Cache[uint64, uint64] { return NewLFU[uint64, uint64](cacheBenchCapacity) })
+}
+
+func BenchmarkARC(b *testing.B) {
+	benchmarkCache(b, func() Cache[uint64, uint64] { return NewARC[uint64, uint64](cacheBenchCapacity) })
+}
@@ -0,0 +1,67 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"iter"
+)
+
+// Codec converts single elements to and from bytes. Collections use it in their Marshal and Unmarshal
+// methods, which write the element count followed by every length-prefixed encoded element.
+type Codec[T any] interface {
+	Encode(T) ([]byte, error)
+	Decode([]byte) (T, error)
+}
+
+var (
+	_ Codec[int] = JSONCodec[int]{}
+	_ Codec[int] = GobCodec[int]{}
+	_ Codec[int] = ElementCodec[int]{}
+)
+
+// JSONCodec encodes elements with encoding/json
+type JSONCodec[T any] struct{}
+
+func (JSONCodec[T]) Encode(v T) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec[T]) Decode(data []byte) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
+
+// GobCodec encodes every element as a self-contained gob stream
+type GobCodec[T any] struct{}
+
+func (GobCodec[T]) Encode(v T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (GobCodec[T]) Decode(data []byte) (T, error) {
+	var v T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v)
+	return v, err
+}
+
+// BinaryCodec returns the compact encoding of DefaultElementCodec
+func BinaryCodec[T any]() Codec[T] {
+	return DefaultElementCodec[T]()
+}
+
+// marshalEntries encodes the n entries of seq as pairs
+func marshalEntries[K, V any](n int, seq iter.Seq2[K, V], codec Codec[Pair[K, V]]) ([]byte, error) {
+	return appendElements(nil, n, func(yield func(Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(Pair[K, V]{k, v}) {
+				return
+			}
+		}
+	}, codec)
+}
@@ -0,0 +1,90 @@
+package collections
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// ConcurrentQueue is an unbounded FIFO queue safe for multiple producers and consumers
+type ConcurrentQueue[T any] struct {
+	mu      sync.Mutex
+	data    []T
+	waiters int
+	// notify is closed and replaced whenever a value arrives while consumers are waiting
+	notify chan struct{}
+}
+
+func NewConcurrentQueue[T any]() *ConcurrentQueue[T] {
+	return &ConcurrentQueue[T]{notify: make(chan struct{})}
+}
+
+func (q *ConcurrentQueue[T]) Enqueue(value T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.data = append(q.data, value)
+	if q.waiters > 0 {
+		close(q.notify)
+		q.notify = make(chan struct{})
+	}
+}
+
+// TryDequeue removes and returns the oldest value without blocking
+func (q *ConcurrentQueue[T]) TryDequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pop()
+}
+
+// Dequeue removes and returns the oldest value, blocking until one is available or ctx is canceled
+func (q *ConcurrentQueue[T]) Dequeue(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if v, ok := q.pop(); ok {
+			q.mu.Unlock()
+			return v, nil
+		}
+		q.waiters++
+		notify := q.notify
+		q.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+		}
+
+		q.mu.Lock()
+		q.waiters--
+		q.mu.Unlock()
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+func (q *ConcurrentQueue[T]) pop() (T, bool) {
+	var zero T
+	if len(q.data) == 0 {
+		return zero, false
+	}
+	val := q.data[0]
+	q.data[0] = zero
+	q.data = q.data[1:]
+	return val, true
+}
+
+func (q *ConcurrentQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.data)
+}
+
+// All() Function returns an iterator over a snapshot of the queue from oldest to newest
+func (q *ConcurrentQueue[T]) All() iter.Seq[T] {
+	q.mu.Lock()
+	snapshot := slices.Clone(q.data)
+	q.mu.Unlock()
+	return slices.Values(snapshot)
+}
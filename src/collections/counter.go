@@ -0,0 +1,114 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Counter counts occurrences of values. Values whose count drops to zero or below are removed.
+type Counter[T comparable] struct {
+	counts map[T]int
+	total  int
+	// first numbers the values in the order they were first counted, to break ties in MostCommon
+	first map[T]int
+	next  int
+}
+
+func NewCounter[T comparable](values ...T) *Counter[T] {
+	c := &Counter[T]{counts: make(map[T]int), first: make(map[T]int)}
+	for _, v := range values {
+		c.Add(v, 1)
+	}
+	return c
+}
+
+// CountSeq counts every value produced by seq
+func CountSeq[T comparable](seq iter.Seq[T]) *Counter[T] {
+	c := NewCounter[T]()
+	for v := range seq {
+		c.Add(v, 1)
+	}
+	return c
+}
+
+// CounterFrom builds a counter from a frequency map such as the one returned by maputil.Frequencies.
+// The values are counted in map iteration order, so ties in MostCommon are broken arbitrarily.
+func CounterFrom[T comparable](freq map[T]int) *Counter[T] {
+	c := NewCounter[T]()
+	for v, n := range freq {
+		c.Add(v, n)
+	}
+	return c
+}
+
+// Add increases the count of value by n
+func (c *Counter[T]) Add(value T, n int) {
+	c.set(value, c.counts[value]+n)
+}
+
+// Subtract decreases the count of value by n
+func (c *Counter[T]) Subtract(value T, n int) {
+	c.set(value, c.counts[value]-n)
+}
+
+func (c *Counter[T]) set(value T, n int) {
+	old, ok := c.counts[value]
+	c.total -= old
+	if n <= 0 {
+		delete(c.counts, value)
+		delete(c.first, value)
+		return
+	}
+	if !ok {
+		c.first[value] = c.next
+		c.next++
+	}
+	c.counts[value] = n
+	c.total += n
+}
+
+// Get returns the count of value, 0 if it was never counted
+func (c *Counter[T]) Get(value T) int {
+	return c.counts[value]
+}
+
+// Total returns the sum of all counts
+func (c *Counter[T]) Total() int {
+	return c.total
+}
+
+// Len returns the number of distinct values
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
+// MostCommon returns an iterator over the n values with the highest counts in descending order of count.
+// Values with equal counts follow the order in which they were first counted. If n <= 0 all values are returned.
+func (c *Counter[T]) MostCommon(n int) iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		keys := slices.Collect(maps.Keys(c.counts))
+		slices.SortFunc(keys, func(a, b T) int {
+			return cmp.Or(cmp.Compare(c.counts[b], c.counts[a]), cmp.Compare(c.first[a], c.first[b]))
+		})
+		if n > 0 && n < len(keys) {
+			keys = keys[:n]
+		}
+		for _, k := range keys {
+			if !yield(k, c.counts[k]) {
+				return
+			}
+		}
+	}
+}
+
+func (c *Counter[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for k, n := range c.counts {
+			if !yield(k, n) {
+				return
+			}
+		}
+	}
+}
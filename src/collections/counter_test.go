@@ -0,0 +1,22 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCounterMostCommonTies(t *testing.T) {
+	c := NewCounter("d", "b", "a", "c", "b", "e", "a")
+	c.Subtract("d", 1)
+	c.Add("d", 1)
+	for range 20 {
+		var got []string
+		for v := range c.MostCommon(0) {
+			got = append(got, v)
+		}
+		// b and a lead with 2; d was removed and counted again, so it comes after c and e
+		if want := []string{"b", "a", "c", "e", "d"}; !slices.Equal(got, want) {
+			t.Fatalf("MostCommon = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"math"
+	"slices"
+)
+
+// CountMinSketch estimates element frequencies in bounded memory. Estimates never undercount.
+type CountMinSketch[T any] struct {
+	counts []uint64
+	width  uint64
+	depth  uint64
+	hash   Hasher[T]
+	total  uint64
+}
+
+// NewCountMinSketch creates a sketch whose estimates exceed the true count by at most epsilon*total
+// with probability 1-delta
+func NewCountMinSketch[T any](epsilon, delta float64, hash Hasher[T]) *CountMinSketch[T] {
+	epsilon = min(max(epsilon, 1e-9), 1)
+	delta = min(max(delta, 1e-12), 0.5)
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := uint64(math.Ceil(math.Log(1 / delta)))
+	return &CountMinSketch[T]{counts: make([]uint64, width*depth), width: width, depth: depth, hash: hash}
+}
+
+// cells yields the counter index of value in every row
+func (s *CountMinSketch[T]) cells(value T) iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		h1 := s.hash(value)
+		h2 := mix64(h1) | 1
+		for i := range s.depth {
+			if !yield(i*s.width + (h1+i*h2)%s.width) {
+				return
+			}
+		}
+	}
+}
+
+// Add counts n occurrences of value
+func (s *CountMinSketch[T]) Add(value T, n uint64) {
+	for c := range s.cells(value) {
+		s.counts[c] += n
+	}
+	s.total += n
+}
+
+// Estimate returns the approximate number of occurrences of value
+func (s *CountMinSketch[T]) Estimate(value T) uint64 {
+	est := uint64(math.MaxUint64)
+	for c := range s.cells(value) {
+		est = min(est, s.counts[c])
+	}
+	return est
+}
+
+// Merge adds the counts of other to s. Both sketches must have the same dimensions.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || s.depth != other.depth {
+		return ErrIncompatible
+	}
+	for i, c := range other.counts {
+		s.counts[i] += c
+	}
+	s.total += other.total
+	return nil
+}
+
+// Total returns the sum of all added counts
+func (s *CountMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+// HeavyHitters returns the elements of seq that make up at least the fraction phi of all elements,
+// ordered by estimated count descending. Elements slightly below the threshold may be included.
+func HeavyHitters[T comparable](seq iter.Seq[T], phi float64, hash Hasher[T]) iter.Seq2[T, uint64] {
+	return func(yield func(T, uint64) bool) {
+		phi = min(max(phi, 1e-6), 1)
+		sketch := NewCountMinSketch(phi/2, 0.01, hash)
+		candidates := make(map[T]uint64)
+		for v := range seq {
+			sketch.Add(v, 1)
+			threshold := uint64(phi * float64(sketch.total))
+			if est := sketch.Estimate(v); est >= threshold {
+				candidates[v] = est
+			}
+			// Drop candidates that fell below the threshold to keep the map small
+			if float64(len(candidates)) > 2/phi {
+				maps.DeleteFunc(candidates, func(_ T, est uint64) bool {
+					return est < threshold
+				})
+			}
+		}
+
+		threshold := uint64(phi * float64(sketch.total))
+		for v := range candidates {
+			candidates[v] = sketch.Estimate(v)
+		}
+		keys := slices.SortedFunc(maps.Keys(candidates), func(a, b T) int {
+			return cmp.Compare(candidates[b], candidates[a])
+		})
+		for _, k := range keys {
+			if candidates[k] < threshold {
+				break
+			}
+			if !yield(k, candidates[k]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package collections
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+const (
+	cuckooBucketSize = 4
+	cuckooMaxKicks   = 500
+)
+
+// CuckooFilter is a probabilistic set like BloomFilter that also supports deleting elements
+type CuckooFilter[T any] struct {
+	buckets [][cuckooBucketSize]uint16
+	mask    uint64
+	count   int
+	hash    Hasher[T]
+	// victim holds a fingerprint that could not be placed after the filter filled up
+	victim      uint16
+	victimIndex uint64
+	rng         *rand.Rand
+}
+
+// NewCuckooFilter creates a filter with room for about capacity elements
+func NewCuckooFilter[T any](capacity int, hash Hasher[T]) *CuckooFilter[T] {
+	n := uint64(1)
+	for n*cuckooBucketSize < uint64(max(capacity, 1)) {
+		n <<= 1
+	}
+	return &CuckooFilter[T]{buckets: make([][cuckooBucketSize]uint16, n), mask: n - 1, hash: hash}
+}
+
+// fingerprint returns the non-zero fingerprint of value and its primary bucket
+func (f *CuckooFilter[T]) fingerprint(value T) (uint16, uint64) {
+	h := f.hash(value)
+	fp := uint16(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+	return fp, h & f.mask
+}
+
+// altIndex returns the other bucket of a fingerprint; it is its own inverse
+func (f *CuckooFilter[T]) altIndex(i uint64, fp uint16) uint64 {
+	return (i ^ mix64(uint64(fp))) & f.mask
+}
+
+func (f *CuckooFilter[T]) insertInto(i uint64, fp uint16) bool {
+	b := &f.buckets[i]
+	for j := range b {
+		if b[j] == 0 {
+			b[j] = fp
+			return true
+		}
+	}
+	return false
+}
+
+// SetRand makes f pick relocation victims with rng, so that insertions are reproducible.
+// A nil rng uses the global source.
+func (f *CuckooFilter[T]) SetRand(rng *rand.Rand) {
+	f.rng = rng
+}
+
+// Add inserts value and reports false if the filter is too full to take it
+func (f *CuckooFilter[T]) Add(value T) bool {
+	if f.victim != 0 {
+		return false
+	}
+	fp, i1 := f.fingerprint(value)
+	i2 := f.altIndex(i1, fp)
+	if f.insertInto(i1, fp) || f.insertInto(i2, fp) {
+		f.count++
+		return true
+	}
+
+	// Relocate existing fingerprints to make room
+	i := i1
+	if randIntN(f.rng, 2) == 0 {
+		i = i2
+	}
+	for range cuckooMaxKicks {
+		j := randIntN(f.rng, cuckooBucketSize)
+		fp, f.buckets[i][j] = f.buckets[i][j], fp
+		i = f.altIndex(i, fp)
+		if f.insertInto(i, fp) {
+			f.count++
+			return true
+		}
+	}
+	f.victim, f.victimIndex = fp, i
+	f.count++
+	return true
+}
+
+func (f *CuckooFilter[T]) bucketContains(i uint64, fp uint16) bool {
+	for _, x := range f.buckets[i] {
+		if x == fp {
+			return true
+		}
+	}
+	return false
+}
+
+// MaybeContains reports false if value is certainly not in the filter, and true if it probably is
+func (f *CuckooFilter[T]) MaybeContains(value T) bool {
+	fp, i1 := f.fingerprint(value)
+	i2 := f.altIndex(i1, fp)
+	if f.victim == fp && (f.victimIndex == i1 || f.victimIndex == i2) {
+		return true
+	}
+	return f.bucketContains(i1, fp) || f.bucketContains(i2, fp)
+}
+
+// Delete removes one occurrence of value and reports whether it was found.
+// Only values that were added may be deleted, otherwise other elements can be lost.
+func (f *CuckooFilter[T]) Delete(value T) bool {
+	fp, i1 := f.fingerprint(value)
+	i2 := f.altIndex(i1, fp)
+	if f.victim == fp && (f.victimIndex == i1 || f.victimIndex == i2) {
+		f.victim = 0
+		f.count--
+		return true
+	}
+	for _, i := range [2]uint64{i1, i2} {
+		b := &f.buckets[i]
+		for j := range b {
+			if b[j] == fp {
+				b[j] = 0
+				f.count--
+				f.reinsertVictim()
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *CuckooFilter[T]) reinsertVictim() {
+	if f.victim == 0 {
+		return
+	}
+	fp, i := f.victim, f.victimIndex
+	f.victim = 0
+	// count already includes the victim
+	if !f.insertInto(i, fp) && !f.insertInto(f.altIndex(i, fp), fp) {
+		f.victim = fp
+	}
+}
+
+func (f *CuckooFilter[T]) Len() int {
+	return f.count
+}
+
+// LoadFactor returns the fraction of occupied fingerprint slots
+func (f *CuckooFilter[T]) LoadFactor() float64 {
+	return float64(f.count) / float64(len(f.buckets)*cuckooBucketSize)
+}
+
+// MarshalBinary encodes the number of buckets, the element count and the victim followed by all fingerprints.
+// The hash function is not encoded and has to be supplied again when decoding.
+func (f *CuckooFilter[T]) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 26+2*cuckooBucketSize*len(f.buckets))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(len(f.buckets)))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(f.count))
+	buf = binary.BigEndian.AppendUint16(buf, f.victim)
+	buf = binary.BigEndian.AppendUint64(buf, f.victimIndex)
+	for _, b := range f.buckets {
+		for _, fp := range b {
+			buf = binary.BigEndian.AppendUint16(buf, fp)
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the contents of f with data produced by MarshalBinary, keeping the hash function of f
+func (f *CuckooFilter[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 26 {
+		return ErrInvalidData
+	}
+	// Compare n with the payload size before multiplying, since a crafted n could make the product wrap
+	n := binary.BigEndian.Uint64(data)
+	payload := len(data) - 26
+	if n == 0 || n&(n-1) != 0 || payload%(2*cuckooBucketSize) != 0 || n != uint64(payload/(2*cuckooBucketSize)) {
+		return ErrInvalidData
+	}
+	count := binary.BigEndian.Uint64(data[8:])
+	victim := binary.BigEndian.Uint16(data[16:])
+	victimIndex := binary.BigEndian.Uint64(data[18:])
+	// the count includes a pending victim, which occupies no slot
+	maxCount := cuckooBucketSize * n
+	if victim != 0 {
+		maxCount++
+	}
+	if victimIndex >= n || count > maxCount {
+		return ErrInvalidData
+	}
+	f.buckets = make([][cuckooBucketSize]uint16, n)
+	f.mask = n - 1
+	f.count = int(count)
+	f.victim = victim
+	f.victimIndex = victimIndex
+	data = data[26:]
+	for i := range f.buckets {
+		for j := range f.buckets[i] {
+			f.buckets[i][j] = binary.BigEndian.Uint16(data)
+			data = data[2:]
+		}
+	}
+	return nil
+}
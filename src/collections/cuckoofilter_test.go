@@ -0,0 +1,50 @@
+package collections
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestCuckooFilterRoundTrip(t *testing.T) {
+	f := NewCuckooFilter(100, StringHasher)
+	f.Add("a")
+	f.Add("b")
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewCuckooFilter(1, StringHasher)
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !g.MaybeContains("a") || !g.MaybeContains("b") || g.Len() != 2 {
+		t.Error("decoded filter lost its elements")
+	}
+}
+
+func TestCuckooFilterUnmarshalInvalid(t *testing.T) {
+	header := func(n, count uint64, buckets int) []byte {
+		data := binary.BigEndian.AppendUint64(nil, n)
+		data = binary.BigEndian.AppendUint64(data, count)
+		data = binary.BigEndian.AppendUint16(data, 0)
+		data = binary.BigEndian.AppendUint64(data, 0)
+		return append(data, make([]byte, 2*cuckooBucketSize*buckets)...)
+	}
+	tests := map[string][]byte{
+		"short":            make([]byte, 25),
+		"zero buckets":     header(0, 0, 0),
+		"not a power of 2": header(3, 0, 3),
+		"wrapping size":    header(1<<62, 0, 0),
+		"missing buckets":  header(4, 0, 2),
+		"trailing bytes":   append(header(2, 0, 2), 0),
+		"negative count":   header(2, 1<<63, 2),
+		"count too large":  header(2, 9, 2),
+	}
+	for name, data := range tests {
+		f := NewCuckooFilter(10, StringHasher)
+		if err := f.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("%s: UnmarshalBinary() = %v, want %v", name, err, ErrInvalidData)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package collections
+
+import "iter"
+
+// DefaultMap is a map that creates missing values with a factory function on first access
+type DefaultMap[K comparable, V any] struct {
+	m       map[K]V
+	factory func() V
+}
+
+func NewDefaultMap[K comparable, V any](factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{m: make(map[K]V), factory: factory}
+}
+
+// Get returns the value for key, storing a new value from the factory if key is missing
+func (d *DefaultMap[K, V]) Get(key K) V {
+	v, ok := d.m[key]
+	if !ok {
+		v = d.factory()
+		d.m[key] = v
+	}
+	return v
+}
+
+// Lookup returns the value for key without creating it
+func (d *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	v, ok := d.m[key]
+	return v, ok
+}
+
+func (d *DefaultMap[K, V]) Set(key K, value V) {
+	d.m[key] = value
+}
+
+// Update replaces the value for key with the result of fn, which receives the existing or a new value
+func (d *DefaultMap[K, V]) Update(key K, fn func(V) V) {
+	d.m[key] = fn(d.Get(key))
+}
+
+func (d *DefaultMap[K, V]) Delete(key K) {
+	delete(d.m, key)
+}
+
+func (d *DefaultMap[K, V]) Has(key K) bool {
+	_, ok := d.m[key]
+	return ok
+}
+
+func (d *DefaultMap[K, V]) Len() int {
+	return len(d.m)
+}
+
+func (d *DefaultMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range d.m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package collections
+
+import "iter"
+
+// Deque is a double-ended queue backed by a growable circular buffer
+type Deque[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+func (d *Deque[T]) grow() {
+	data := make([]T, max(2*len(d.data), 8))
+	n := copy(data, d.data[d.head:])
+	copy(data[n:], d.data[:d.head])
+	d.data = data
+	d.head = 0
+}
+
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.data)
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	if d.size == len(d.data) {
+		d.grow()
+	}
+	d.head = (d.head - 1 + len(d.data)) % len(d.data)
+	d.data[d.head] = value
+	d.size++
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	if d.size == len(d.data) {
+		d.grow()
+	}
+	d.data[d.index(d.size)] = value
+	d.size++
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	val := d.data[d.head]
+	d.data[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return val, true
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	i := d.index(d.size - 1)
+	val := d.data[i]
+	d.data[i] = zero
+	d.size--
+	return val, true
+}
+
+func (d *Deque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.data[d.head], true
+}
+
+func (d *Deque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.data[d.index(d.size-1)], true
+}
+
+// At returns the i-th element counted from the front
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.size {
+		panic("deque: index out of range")
+	}
+	return d.data[d.index(i)]
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// All() Function returns an iterator over all elements from front to back
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.size {
+			if !yield(d.data[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all elements from back to front
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.data[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary encodes the elements from front to back with DefaultElementCodec
+func (d *Deque[T]) MarshalBinary() ([]byte, error) {
+	return d.Marshal(DefaultElementCodec[T]())
+}
+
+// Marshal encodes the element count followed by every length-prefixed element encoded by codec
+func (d *Deque[T]) Marshal(codec Codec[T]) ([]byte, error) {
+	return appendElements(nil, d.Len(), d.All(), codec)
+}
+
+// UnmarshalBinary replaces the contents of d with data produced by MarshalBinary
+func (d *Deque[T]) UnmarshalBinary(data []byte) error {
+	return d.Unmarshal(data, DefaultElementCodec[T]())
+}
+
+// Unmarshal replaces the contents of d with data produced by Marshal using the same codec
+func (d *Deque[T]) Unmarshal(data []byte, codec Codec[T]) error {
+	values, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	d.data, d.head, d.size = values, 0, len(values)
+	return nil
+}
+
+// FormatWith renders the elements from front to back according to f
+func (d *Deque[T]) FormatWith(f TextFormat) string {
+	return formatElements(sprintSeq(d.All()), d.Len(), f)
+}
+
+func (d *Deque[T]) String() string {
+	return d.FormatWith(TextFormat{})
+}
+
+func (d *Deque[T]) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Validate checks that the head and size fit the circular buffer
+func (d *Deque[T]) Validate() error {
+	if d.size < 0 || d.size > len(d.data) {
+		return invariantf("size %d with capacity %d", d.size, len(d.data))
+	}
+	if d.head < 0 || (len(d.data) > 0 && d.head >= len(d.data)) || (len(d.data) == 0 && d.head != 0) {
+		return invariantf("head %d with capacity %d", d.head, len(d.data))
+	}
+	return nil
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (d *Deque[T]) CheckInvariants() {
+	checkInvariants(d)
+}
@@ -0,0 +1,111 @@
+package collections
+
+import "iter"
+
+// DisjointSet partitions elements into sets using union by rank and path compression.
+// Elements are added on first use.
+type DisjointSet[T comparable] struct {
+	index  map[T]int
+	items  []T
+	parent []int
+	rank   []uint8
+	sets   int
+}
+
+func NewDisjointSet[T comparable](values ...T) *DisjointSet[T] {
+	d := &DisjointSet[T]{index: make(map[T]int)}
+	for _, v := range values {
+		d.Add(v)
+	}
+	return d
+}
+
+// Add inserts value as a singleton set if it is not yet present
+func (d *DisjointSet[T]) Add(value T) {
+	d.id(value)
+}
+
+func (d *DisjointSet[T]) id(value T) int {
+	if i, ok := d.index[value]; ok {
+		return i
+	}
+	i := len(d.items)
+	d.index[value] = i
+	d.items = append(d.items, value)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.sets++
+	return i
+}
+
+func (d *DisjointSet[T]) root(i int) int {
+	r := i
+	for d.parent[r] != r {
+		r = d.parent[r]
+	}
+	for d.parent[i] != r {
+		d.parent[i], i = r, d.parent[i]
+	}
+	return r
+}
+
+// Find returns the representative of the set containing value
+func (d *DisjointSet[T]) Find(value T) T {
+	return d.items[d.root(d.id(value))]
+}
+
+// Union merges the sets of a and b and reports whether they were separate before
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	ra, rb := d.root(d.id(a)), d.root(d.id(b))
+	if ra == rb {
+		return false
+	}
+	if d.rank[ra] < d.rank[rb] {
+		ra, rb = rb, ra
+	}
+	d.parent[rb] = ra
+	if d.rank[ra] == d.rank[rb] {
+		d.rank[ra]++
+	}
+	d.sets--
+	return true
+}
+
+func (d *DisjointSet[T]) SameSet(a, b T) bool {
+	ia, ok := d.index[a]
+	if !ok {
+		return false
+	}
+	ib, ok := d.index[b]
+	return ok && d.root(ia) == d.root(ib)
+}
+
+// Len returns the number of elements
+func (d *DisjointSet[T]) Len() int {
+	return len(d.items)
+}
+
+// SetCount returns the number of disjoint sets
+func (d *DisjointSet[T]) SetCount() int {
+	return d.sets
+}
+
+// Components returns an iterator over all sets as pairs of representative and members
+func (d *DisjointSet[T]) Components() iter.Seq2[T, []T] {
+	return func(yield func(T, []T) bool) {
+		groups := make(map[int][]T)
+		var roots []int
+		for i, v := range d.items {
+			r := d.root(i)
+			if _, ok := groups[r]; !ok {
+				roots = append(roots, r)
+			}
+			groups[r] = append(groups[r], v)
+		}
+		for _, r := range roots {
+			if !yield(d.items[r], groups[r]) {
+				return
+			}
+		}
+	}
+}
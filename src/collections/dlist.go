@@ -0,0 +1,207 @@
+package collections
+
+import "iter"
+
+// DList is a doubly linked list, a type-safe version of container/list
+type DList[T any] struct {
+	// root is a sentinel, root.next is the front and root.prev the back of the list
+	root  DListElement[T]
+	size  int
+	nodes *Arena[DListElement[T]]
+}
+
+// DListElement is a handle to a value stored in a DList
+type DListElement[T any] struct {
+	Value T
+	next  *DListElement[T]
+	prev  *DListElement[T]
+	list  *DList[T]
+}
+
+// Next returns the following element or nil at the back of the list
+func (e *DListElement[T]) Next() *DListElement[T] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+	return nil
+}
+
+// Prev returns the preceding element or nil at the front of the list
+func (e *DListElement[T]) Prev() *DListElement[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+func NewDList[T any]() *DList[T] {
+	return new(DList[T]).init()
+}
+
+// NewDListWithArena creates a list that allocates its elements in blocks of blockSize.
+// Removed elements are reused, so an element must not be used after it was removed.
+func NewDListWithArena[T any](blockSize int) *DList[T] {
+	l := NewDList[T]()
+	l.nodes = NewArena[DListElement[T]](blockSize)
+	return l
+}
+
+func (l *DList[T]) newElement(value T) *DListElement[T] {
+	e := l.nodes.New()
+	e.Value = value
+	return e
+}
+
+func (l *DList[T]) init() *DList[T] {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+	return l
+}
+
+// lazyInit makes the zero value usable
+func (l *DList[T]) lazyInit() {
+	if l.root.next == nil {
+		l.init()
+	}
+}
+
+func (l *DList[T]) Len() int {
+	return l.size
+}
+
+func (l *DList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+func (l *DList[T]) Front() *DListElement[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+func (l *DList[T]) Back() *DListElement[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *DList[T]) insert(e, at *DListElement[T]) *DListElement[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.size++
+	return e
+}
+
+func (l *DList[T]) unlink(e *DListElement[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+func (l *DList[T]) move(e, at *DListElement[T]) {
+	if e == at {
+		return
+	}
+	l.unlink(e)
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+func (l *DList[T]) PushFront(value T) *DListElement[T] {
+	l.lazyInit()
+	return l.insert(l.newElement(value), &l.root)
+}
+
+func (l *DList[T]) PushBack(value T) *DListElement[T] {
+	l.lazyInit()
+	return l.insert(l.newElement(value), l.root.prev)
+}
+
+// InsertBefore inserts value directly before mark, which must be an element of l
+func (l *DList[T]) InsertBefore(value T, mark *DListElement[T]) *DListElement[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(l.newElement(value), mark.prev)
+}
+
+// InsertAfter inserts value directly after mark, which must be an element of l
+func (l *DList[T]) InsertAfter(value T, mark *DListElement[T]) *DListElement[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(l.newElement(value), mark)
+}
+
+// Remove removes e from l if it is an element of l and returns its value
+func (l *DList[T]) Remove(e *DListElement[T]) T {
+	value := e.Value
+	if e.list == l {
+		l.unlink(e)
+		e.next = nil
+		e.prev = nil
+		e.list = nil
+		l.size--
+		l.nodes.Free(e)
+	}
+	return value
+}
+
+func (l *DList[T]) MoveToFront(e *DListElement[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
+
+func (l *DList[T]) MoveToBack(e *DListElement[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.move(e, l.root.prev)
+}
+
+// MoveBefore moves e directly before mark, both must be elements of l
+func (l *DList[T]) MoveBefore(e, mark *DListElement[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+	l.move(e, mark.prev)
+}
+
+// MoveAfter moves e directly after mark, both must be elements of l
+func (l *DList[T]) MoveAfter(e, mark *DListElement[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+	l.move(e, mark)
+}
+
+// All() Function returns an iterator over all values from front to back
+func (l *DList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all values from back to front
+func (l *DList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
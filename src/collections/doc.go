@@ -0,0 +1,2 @@
+// Package collections provides generic container types that expose their contents as iterators.
+package collections
@@ -0,0 +1,80 @@
+package collections
+
+import "iter"
+
+// EvictionPolicy decides what an EvictingBuffer does with a value pushed while it is full
+type EvictionPolicy int
+
+const (
+	// EvictOldest discards the oldest buffered value to make room
+	EvictOldest EvictionPolicy = iota
+	// EvictNewest discards the most recently buffered value to make room
+	EvictNewest
+	// EvictReject discards the pushed value and keeps the buffered ones
+	EvictReject
+)
+
+// EvictingBuffer is a fixed-capacity FIFO buffer that never blocks a producer. When it is full,
+// values are discarded according to its policy and counted.
+type EvictingBuffer[T any] struct {
+	data     Deque[T]
+	capacity int
+	policy   EvictionPolicy
+	dropped  uint64
+}
+
+func NewEvictingBuffer[T any](capacity int, policy EvictionPolicy) *EvictingBuffer[T] {
+	return &EvictingBuffer[T]{capacity: max(capacity, 1), policy: policy}
+}
+
+// Push adds value and reports whether it was stored. With EvictOldest and EvictNewest a value is
+// always stored, possibly discarding another one.
+func (b *EvictingBuffer[T]) Push(value T) bool {
+	if b.data.Len() >= b.capacity {
+		b.dropped++
+		switch b.policy {
+		case EvictOldest:
+			b.data.PopFront()
+		case EvictNewest:
+			b.data.PopBack()
+		default:
+			return false
+		}
+	}
+	b.data.PushBack(value)
+	return true
+}
+
+// Pop removes and returns the oldest value
+func (b *EvictingBuffer[T]) Pop() (T, bool) {
+	return b.data.PopFront()
+}
+
+// Dropped returns how many values were discarded because the buffer was full
+func (b *EvictingBuffer[T]) Dropped() uint64 {
+	return b.dropped
+}
+
+func (b *EvictingBuffer[T]) Len() int {
+	return b.data.Len()
+}
+
+func (b *EvictingBuffer[T]) Cap() int {
+	return b.capacity
+}
+
+func (b *EvictingBuffer[T]) IsFull() bool {
+	return b.data.Len() >= b.capacity
+}
+
+// Drain returns an iterator that pops the buffered values from oldest to newest
+func (b *EvictingBuffer[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := b.Pop()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+
+	"ROFT_examples/constraints"
+)
+
+// Fenwick is a binary indexed tree over n numbers with point updates and prefix sums in O(log n)
+type Fenwick[T constraints.Number] struct {
+	tree []T
+}
+
+func NewFenwick[T constraints.Number](n int) *Fenwick[T] {
+	return &Fenwick[T]{tree: make([]T, max(n, 0))}
+}
+
+// FenwickFrom builds a tree over values in O(n)
+func FenwickFrom[T constraints.Number](values []T) *Fenwick[T] {
+	f := &Fenwick[T]{tree: slices.Clone(values)}
+	for i := range f.tree {
+		if j := i | (i + 1); j < len(f.tree) {
+			f.tree[j] += f.tree[i]
+		}
+	}
+	return f
+}
+
+// CollectFenwick builds a tree over the values of seq in O(n)
+func CollectFenwick[T constraints.Number](seq iter.Seq[T]) *Fenwick[T] {
+	return FenwickFrom(slices.Collect(seq))
+}
+
+func (f *Fenwick[T]) Len() int {
+	return len(f.tree)
+}
+
+// Add adds delta to the value at index i
+func (f *Fenwick[T]) Add(i int, delta T) {
+	for ; i < len(f.tree); i |= i + 1 {
+		f.tree[i] += delta
+	}
+}
+
+// Set replaces the value at index i
+func (f *Fenwick[T]) Set(i int, value T) {
+	f.Add(i, value-f.RangeSum(i, i+1))
+}
+
+// PrefixSum returns the sum of the values in the index range [0, i)
+func (f *Fenwick[T]) PrefixSum(i int) T {
+	var sum T
+	for i = min(i, len(f.tree)) - 1; i >= 0; i = i&(i+1) - 1 {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the values in the index range [l, r)
+func (f *Fenwick[T]) RangeSum(l, r int) T {
+	if l >= r {
+		var zero T
+		return zero
+	}
+	return f.PrefixSum(r) - f.PrefixSum(l)
+}
@@ -0,0 +1,88 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+)
+
+// FrozenSet is an immutable set. It has no mutating methods, so it can be shared freely between goroutines.
+type FrozenSet[T comparable] struct {
+	data map[T]struct{}
+}
+
+// Freeze returns an immutable copy of s. Later changes to s do not affect it.
+func (s *Set[T]) Freeze() FrozenSet[T] {
+	return FrozenSet[T]{data: maps.Clone(s.data)}
+}
+
+func (s FrozenSet[T]) Contains(value T) bool {
+	_, ok := s.data[value]
+	return ok
+}
+
+func (s FrozenSet[T]) Len() int {
+	return len(s.data)
+}
+
+func (s FrozenSet[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Thaw returns a mutable copy of the set
+func (s FrozenSet[T]) Thaw() *Set[T] {
+	data := maps.Clone(s.data)
+	if data == nil {
+		data = make(map[T]struct{})
+	}
+	return &Set[T]{data: data}
+}
+
+func (s FrozenSet[T]) All() iter.Seq[T] {
+	return maps.Keys(s.data)
+}
+
+// FrozenMap is an immutable map. It has no mutating methods, so it can be shared freely between goroutines.
+type FrozenMap[K comparable, V any] struct {
+	data map[K]V
+}
+
+// FreezeMap returns an immutable copy of m
+func FreezeMap[K comparable, V any](m map[K]V) FrozenMap[K, V] {
+	return FrozenMap[K, V]{data: maps.Clone(m)}
+}
+
+// Freeze returns an immutable copy of the current contents of m
+func (m *SyncMap[K, V]) Freeze() FrozenMap[K, V] {
+	return FrozenMap[K, V]{data: m.Snapshot()}
+}
+
+func (m FrozenMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.data[key]
+	return v, ok
+}
+
+func (m FrozenMap[K, V]) Has(key K) bool {
+	_, ok := m.data[key]
+	return ok
+}
+
+func (m FrozenMap[K, V]) Len() int {
+	return len(m.data)
+}
+
+// Thaw returns a mutable copy of the map
+func (m FrozenMap[K, V]) Thaw() map[K]V {
+	data := maps.Clone(m.data)
+	if data == nil {
+		data = make(map[K]V)
+	}
+	return data
+}
+
+func (m FrozenMap[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(m.data)
+}
+
+func (m FrozenMap[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(m.data)
+}
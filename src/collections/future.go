@@ -0,0 +1,139 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Future holds a result that becomes available at some later point in time
+type Future[T any] struct {
+	once  sync.Once
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func NewFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+// Async runs fn in a new goroutine and returns a future for its result
+func Async[T any](fn func() (T, error)) *Future[T] {
+	f := NewFuture[T]()
+	go func() {
+		v, err := fn()
+		f.complete(v, err)
+	}()
+	return f
+}
+
+func (f *Future[T]) complete(value T, err error) bool {
+	completed := false
+	f.once.Do(func() {
+		f.value, f.err = value, err
+		close(f.done)
+		completed = true
+	})
+	return completed
+}
+
+// Resolve completes the future with value and reports false if it was already completed
+func (f *Future[T]) Resolve(value T) bool {
+	return f.complete(value, nil)
+}
+
+// Reject completes the future with err and reports false if it was already completed
+func (f *Future[T]) Reject(err error) bool {
+	var zero T
+	return f.complete(zero, err)
+}
+
+// Done returns a channel that is closed once the future is completed
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits until the future is completed or ctx is canceled
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// All returns a future resolving to all values in order once every future resolved, or rejecting with the first error
+func All[T any](futures ...*Future[T]) *Future[[]T] {
+	out := NewFuture[[]T]()
+	var (
+		mu     sync.Mutex
+		values = make([]T, len(futures))
+		left   = len(futures)
+	)
+	if left == 0 {
+		out.Resolve(values)
+	}
+	for i, f := range futures {
+		go func() {
+			<-f.done
+			if f.err != nil {
+				out.Reject(f.err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			values[i] = f.value
+			if left--; left == 0 {
+				out.Resolve(values)
+			}
+		}()
+	}
+	return out
+}
+
+// Any returns a future resolving to the first value of any resolved future, or rejecting with all errors if all fail
+func Any[T any](futures ...*Future[T]) *Future[T] {
+	out := NewFuture[T]()
+	var (
+		mu   sync.Mutex
+		errs = make([]error, len(futures))
+		left = len(futures)
+	)
+	if left == 0 {
+		out.Reject(errors.New("any: no futures given"))
+	}
+	for i, f := range futures {
+		go func() {
+			<-f.done
+			if f.err == nil {
+				out.Resolve(f.value)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			errs[i] = f.err
+			if left--; left == 0 {
+				out.Reject(errors.Join(errs...))
+			}
+		}()
+	}
+	return out
+}
+
+// Then returns a future for the result of applying fn to the value of f once it resolves
+func Then[T, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	out := NewFuture[U]()
+	go func() {
+		<-f.done
+		if f.err != nil {
+			out.Reject(f.err)
+			return
+		}
+		u, err := fn(f.value)
+		out.complete(u, err)
+	}()
+	return out
+}
@@ -0,0 +1,23 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/gob"
+	"iter"
+	"slices"
+)
+
+// gobEncodeSeq encodes the elements of seq as a single gob slice
+func gobEncodeSeq[T any](seq iter.Seq[T]) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(slices.Collect(seq)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecodeSlice[T any](data []byte) ([]T, error) {
+	var s []T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s)
+	return s, err
+}
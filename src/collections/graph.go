@@ -0,0 +1,181 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+)
+
+// Edge is an outgoing edge in a Graph
+type Edge[N comparable] struct {
+	To     N
+	Weight float64
+}
+
+// Graph is a directed or undirected weighted graph stored as adjacency lists.
+// Nodes and neighbors are iterated in insertion order.
+type Graph[N comparable] struct {
+	directed bool
+	nodes    []N
+	adj      map[N][]Edge[N]
+	edges    int
+}
+
+func NewGraph[N comparable](directed bool) *Graph[N] {
+	return &Graph[N]{directed: directed, adj: make(map[N][]Edge[N])}
+}
+
+func (g *Graph[N]) Directed() bool {
+	return g.directed
+}
+
+// AddNode adds n if it is not yet part of the graph
+func (g *Graph[N]) AddNode(n N) {
+	if _, ok := g.adj[n]; !ok {
+		g.adj[n] = nil
+		g.nodes = append(g.nodes, n)
+	}
+}
+
+func (g *Graph[N]) HasNode(n N) bool {
+	_, ok := g.adj[n]
+	return ok
+}
+
+// AddEdge adds an edge with weight 1 between from and to, adding missing nodes.
+// Duplicate edges are ignored, so an existing edge keeps its weight.
+func (g *Graph[N]) AddEdge(from, to N) {
+	if !g.HasEdge(from, to) {
+		g.AddWeightedEdge(from, to, 1)
+	}
+}
+
+// AddWeightedEdge adds an edge between from and to, adding missing nodes.
+// If the edge already exists only its weight is changed.
+func (g *Graph[N]) AddWeightedEdge(from, to N, weight float64) {
+	g.AddNode(from)
+	g.AddNode(to)
+	if !g.setWeight(from, to, weight) {
+		g.adj[from] = append(g.adj[from], Edge[N]{to, weight})
+		g.edges++
+	}
+	if !g.directed && from != to && !g.setWeight(to, from, weight) {
+		g.adj[to] = append(g.adj[to], Edge[N]{from, weight})
+	}
+}
+
+func (g *Graph[N]) setWeight(from, to N, weight float64) bool {
+	i := g.edgeIndex(from, to)
+	if i >= 0 {
+		g.adj[from][i].Weight = weight
+	}
+	return i >= 0
+}
+
+func (g *Graph[N]) edgeIndex(from, to N) int {
+	return slices.IndexFunc(g.adj[from], func(e Edge[N]) bool { return e.To == to })
+}
+
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	return g.edgeIndex(from, to) >= 0
+}
+
+// Weight returns the weight of the edge from from to to
+func (g *Graph[N]) Weight(from, to N) (float64, bool) {
+	if i := g.edgeIndex(from, to); i >= 0 {
+		return g.adj[from][i].Weight, true
+	}
+	return 0, false
+}
+
+func (g *Graph[N]) NodeCount() int {
+	return len(g.nodes)
+}
+
+func (g *Graph[N]) EdgeCount() int {
+	return g.edges
+}
+
+func (g *Graph[N]) Nodes() iter.Seq[N] {
+	return func(yield func(N) bool) {
+		for _, n := range g.nodes {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iterator over the nodes reachable from n by one edge
+func (g *Graph[N]) Neighbors(n N) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		for _, e := range g.adj[n] {
+			if !yield(e.To) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over the outgoing edges of n
+func (g *Graph[N]) Edges(n N) iter.Seq[Edge[N]] {
+	return func(yield func(Edge[N]) bool) {
+		for _, e := range g.adj[n] {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// BFS returns an iterator over the nodes reachable from start in breadth-first order
+func (g *Graph[N]) BFS(start N) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := map[N]bool{start: true}
+		q := NewQueue[N]()
+		q.Enqueue(start)
+		for !q.IsEmpty() {
+			n, _ := q.Dequeue()
+			if !yield(n) {
+				return
+			}
+			for _, e := range g.adj[n] {
+				if !visited[e.To] {
+					visited[e.To] = true
+					q.Enqueue(e.To)
+				}
+			}
+		}
+	}
+}
+
+// DFS returns an iterator over the nodes reachable from start in depth-first preorder
+func (g *Graph[N]) DFS(start N) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := make(map[N]bool)
+		s := NewStack[N]()
+		s.Push(start)
+		for !s.IsEmpty() {
+			n, _ := s.Pop()
+			if visited[n] {
+				continue
+			}
+			visited[n] = true
+			if !yield(n) {
+				return
+			}
+			// Push in reverse so that the first neighbor is visited first
+			adj := g.adj[n]
+			for i := len(adj) - 1; i >= 0; i-- {
+				if !visited[adj[i].To] {
+					s.Push(adj[i].To)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package collections
+
+import "testing"
+
+func TestAddEdgeKeepsWeight(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		g := NewGraph[string](directed)
+		g.AddWeightedEdge("a", "b", 5)
+		g.AddEdge("a", "b")
+		if w, _ := g.Weight("a", "b"); w != 5 || g.EdgeCount() != 1 {
+			t.Errorf("directed %v: weight %v and %d edges after a duplicate AddEdge", directed, w, g.EdgeCount())
+		}
+	}
+}
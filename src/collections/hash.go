@@ -0,0 +1,42 @@
+package collections
+
+import (
+	"hash/fnv"
+	"hash/maphash"
+)
+
+// Hasher maps a value to a 64-bit hash, used by the probabilistic filters and sketches
+type Hasher[T any] func(T) uint64
+
+// StringHasher hashes strings with FNV-1a. Its results are stable across processes, so it is suited for persisted filters.
+func StringHasher(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// BytesHasher hashes byte slices with FNV-1a. Its results are stable across processes.
+func BytesHasher(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// ComparableHasher returns a hasher for any comparable type based on hash/maphash.
+// The seed is chosen randomly, so hashes differ between processes and must not be persisted.
+func ComparableHasher[T comparable]() Hasher[T] {
+	seed := maphash.MakeSeed()
+	return func(v T) uint64 {
+		return maphash.Comparable(seed, v)
+	}
+}
+
+// mix64 is the finalizer of SplitMix64, used to derive additional hashes from one
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
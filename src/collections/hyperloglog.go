@@ -0,0 +1,73 @@
+package collections
+
+import (
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates the number of distinct elements using 2^precision one-byte registers
+type HyperLogLog[T any] struct {
+	registers []uint8
+	p         uint8
+	hash      Hasher[T]
+}
+
+// NewHyperLogLog creates an estimator with the given precision, clamped to [4, 18].
+// The standard error is about 1.04/sqrt(2^precision).
+func NewHyperLogLog[T any](precision int, hash Hasher[T]) *HyperLogLog[T] {
+	p := uint8(min(max(precision, 4), 18))
+	return &HyperLogLog[T]{registers: make([]uint8, 1<<p), p: p, hash: hash}
+}
+
+func (h *HyperLogLog[T]) Add(value T) {
+	x := mix64(h.hash(value))
+	idx := x >> (64 - h.p)
+	// The guard bit bounds the rank if all remaining bits are zero
+	w := x<<h.p | 1<<(h.p-1)
+	rank := uint8(bits.LeadingZeros64(w) + 1)
+	h.registers[idx] = max(h.registers[idx], rank)
+}
+
+// Count returns the estimated number of distinct elements added
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	est := alpha * m * m / sum
+	// Linear counting is more accurate for small cardinalities
+	if est <= 2.5*m && zeros > 0 {
+		est = m * math.Log(m/float64(zeros))
+	}
+	return uint64(est + 0.5)
+}
+
+// Merge combines other into h so that h estimates the union of both. Both must have the same precision.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.p != other.p {
+		return ErrIncompatible
+	}
+	for i, r := range other.registers {
+		h.registers[i] = max(h.registers[i], r)
+	}
+	return nil
+}
+
+func (h *HyperLogLog[T]) Precision() int {
+	return int(h.p)
+}
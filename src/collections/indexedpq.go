@@ -0,0 +1,184 @@
+package collections
+
+import "cmp"
+
+// IndexedPriorityQueue is a min-heap of keys whose priorities can be changed in O(log n)
+type IndexedPriorityQueue[K comparable, P cmp.Ordered] struct {
+	heap  []ipqEntry[K, P]
+	index map[K]int
+}
+
+type ipqEntry[K comparable, P cmp.Ordered] struct {
+	key  K
+	prio P
+}
+
+func NewIndexedPriorityQueue[K comparable, P cmp.Ordered]() *IndexedPriorityQueue[K, P] {
+	return &IndexedPriorityQueue[K, P]{index: make(map[K]int)}
+}
+
+// Push inserts key with prio, or changes its priority if key is already queued
+func (q *IndexedPriorityQueue[K, P]) Push(key K, prio P) {
+	if i, ok := q.index[key]; ok {
+		q.set(i, prio)
+		return
+	}
+	q.heap = append(q.heap, ipqEntry[K, P]{key, prio})
+	q.index[key] = len(q.heap) - 1
+	q.up(len(q.heap) - 1)
+}
+
+// Update changes the priority of a queued key and reports false if key is not queued
+func (q *IndexedPriorityQueue[K, P]) Update(key K, prio P) bool {
+	i, ok := q.index[key]
+	if ok {
+		q.set(i, prio)
+	}
+	return ok
+}
+
+// DecreaseKey lowers the priority of a queued key and reports whether the priority was changed
+func (q *IndexedPriorityQueue[K, P]) DecreaseKey(key K, prio P) bool {
+	i, ok := q.index[key]
+	if !ok || prio >= q.heap[i].prio {
+		return false
+	}
+	q.heap[i].prio = prio
+	q.up(i)
+	return true
+}
+
+func (q *IndexedPriorityQueue[K, P]) set(i int, prio P) {
+	old := q.heap[i].prio
+	q.heap[i].prio = prio
+	if prio < old {
+		q.up(i)
+	} else {
+		q.down(i)
+	}
+}
+
+// Pop removes and returns the key with the lowest priority
+func (q *IndexedPriorityQueue[K, P]) Pop() (K, P, bool) {
+	if len(q.heap) == 0 {
+		var (
+			zeroK K
+			zeroP P
+		)
+		return zeroK, zeroP, false
+	}
+	top := q.heap[0]
+	q.removeAt(0)
+	return top.key, top.prio, true
+}
+
+// Peek returns the key with the lowest priority without removing it
+func (q *IndexedPriorityQueue[K, P]) Peek() (K, P, bool) {
+	if len(q.heap) == 0 {
+		var (
+			zeroK K
+			zeroP P
+		)
+		return zeroK, zeroP, false
+	}
+	return q.heap[0].key, q.heap[0].prio, true
+}
+
+// Remove deletes key from the queue and reports whether it was queued
+func (q *IndexedPriorityQueue[K, P]) Remove(key K) bool {
+	i, ok := q.index[key]
+	if ok {
+		q.removeAt(i)
+	}
+	return ok
+}
+
+func (q *IndexedPriorityQueue[K, P]) removeAt(i int) {
+	last := len(q.heap) - 1
+	delete(q.index, q.heap[i].key)
+	if i != last {
+		q.heap[i] = q.heap[last]
+		q.index[q.heap[i].key] = i
+	}
+	q.heap = q.heap[:last]
+	if i < last {
+		q.down(i)
+		q.up(i)
+	}
+}
+
+func (q *IndexedPriorityQueue[K, P]) Contains(key K) bool {
+	_, ok := q.index[key]
+	return ok
+}
+
+// Priority returns the current priority of a queued key
+func (q *IndexedPriorityQueue[K, P]) Priority(key K) (P, bool) {
+	if i, ok := q.index[key]; ok {
+		return q.heap[i].prio, true
+	}
+	var zero P
+	return zero, false
+}
+
+func (q *IndexedPriorityQueue[K, P]) Len() int {
+	return len(q.heap)
+}
+
+func (q *IndexedPriorityQueue[K, P]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+func (q *IndexedPriorityQueue[K, P]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.index[q.heap[i].key] = i
+	q.index[q.heap[j].key] = j
+}
+
+func (q *IndexedPriorityQueue[K, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if q.heap[i].prio >= q.heap[parent].prio {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+func (q *IndexedPriorityQueue[K, P]) down(i int) {
+	for {
+		best := i
+		for _, c := range [2]int{2*i + 1, 2*i + 2} {
+			if c < len(q.heap) && q.heap[c].prio < q.heap[best].prio {
+				best = c
+			}
+		}
+		if best == i {
+			return
+		}
+		q.swap(i, best)
+		i = best
+	}
+}
+
+// Validate checks the heap order on priorities and that the index maps every key to its heap position
+func (q *IndexedPriorityQueue[K, P]) Validate() error {
+	if len(q.index) != len(q.heap) {
+		return invariantf("index holds %d keys, heap %d", len(q.index), len(q.heap))
+	}
+	for i, e := range q.heap {
+		if j, ok := q.index[e.key]; !ok || j != i {
+			return invariantf("key %v at position %d is indexed at %d", e.key, i, j)
+		}
+		if i > 0 && e.prio < q.heap[(i-1)/2].prio {
+			return invariantf("key %v has a lower priority than its parent", e.key)
+		}
+	}
+	return nil
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (q *IndexedPriorityQueue[K, P]) CheckInvariants() {
+	checkInvariants(q)
+}
@@ -0,0 +1,129 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sort"
+)
+
+// Range is the half-open interval [Lo, Hi). It is empty if Lo >= Hi.
+type Range[T cmp.Ordered] struct {
+	Lo, Hi T
+}
+
+// IsEmpty reports whether the range contains no points
+func (r Range[T]) IsEmpty() bool {
+	return r.Lo >= r.Hi
+}
+
+// Contains reports whether p lies within the range
+func (r Range[T]) Contains(p T) bool {
+	return r.Lo <= p && p < r.Hi
+}
+
+// Overlaps reports whether both ranges share at least one point. Ranges that only touch do not overlap.
+func (r Range[T]) Overlaps(other Range[T]) bool {
+	return r.Lo < other.Hi && other.Lo < r.Hi && !r.IsEmpty() && !other.IsEmpty()
+}
+
+// IntervalSet is a set of values stored as sorted, non-overlapping, non-empty ranges.
+// Overlapping and adjacent ranges are merged when added.
+type IntervalSet[T cmp.Ordered] struct {
+	data []Range[T]
+}
+
+func NewIntervalSet[T cmp.Ordered](ranges ...Range[T]) *IntervalSet[T] {
+	s := &IntervalSet[T]{}
+	for _, r := range ranges {
+		s.Add(r.Lo, r.Hi)
+	}
+	return s
+}
+
+// search returns the index of the first range for which pred is true
+func (s *IntervalSet[T]) search(pred func(Range[T]) bool) int {
+	return sort.Search(len(s.data), func(i int) bool { return pred(s.data[i]) })
+}
+
+// Add inserts [lo, hi). Empty ranges are ignored.
+func (s *IntervalSet[T]) Add(lo, hi T) {
+	if lo >= hi {
+		return
+	}
+	i := s.search(func(iv Range[T]) bool { return iv.Hi >= lo })
+	j := s.search(func(iv Range[T]) bool { return iv.Lo > hi })
+	if i < j {
+		lo = min(lo, s.data[i].Lo)
+		hi = max(hi, s.data[j-1].Hi)
+	}
+	s.data = slices.Replace(s.data, i, j, Range[T]{lo, hi})
+}
+
+// Remove deletes [lo, hi), splitting ranges that contain it
+func (s *IntervalSet[T]) Remove(lo, hi T) {
+	if lo >= hi {
+		return
+	}
+	i := s.search(func(iv Range[T]) bool { return iv.Hi > lo })
+	j := s.search(func(iv Range[T]) bool { return iv.Lo >= hi })
+	if i >= j {
+		return
+	}
+	var keep []Range[T]
+	if s.data[i].Lo < lo {
+		keep = append(keep, Range[T]{s.data[i].Lo, lo})
+	}
+	if s.data[j-1].Hi > hi {
+		keep = append(keep, Range[T]{hi, s.data[j-1].Hi})
+	}
+	s.data = slices.Replace(s.data, i, j, keep...)
+}
+
+// Contains reports whether p lies in one of the ranges
+func (s *IntervalSet[T]) Contains(p T) bool {
+	i := s.search(func(iv Range[T]) bool { return iv.Hi > p })
+	return i < len(s.data) && s.data[i].Contains(p)
+}
+
+// Len returns the number of ranges
+func (s *IntervalSet[T]) Len() int {
+	return len(s.data)
+}
+
+func (s *IntervalSet[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Complement returns the parts of bounds that are not covered by s
+func (s *IntervalSet[T]) Complement(bounds Range[T]) *IntervalSet[T] {
+	r := &IntervalSet[T]{}
+	lo := bounds.Lo
+	for _, iv := range s.data {
+		if iv.Hi <= bounds.Lo {
+			continue
+		}
+		if iv.Lo >= bounds.Hi {
+			break
+		}
+		if lo < iv.Lo {
+			r.data = append(r.data, Range[T]{lo, iv.Lo})
+		}
+		lo = max(lo, iv.Hi)
+	}
+	if lo < bounds.Hi {
+		r.data = append(r.data, Range[T]{lo, bounds.Hi})
+	}
+	return r
+}
+
+// All returns an iterator over the ranges in ascending order
+func (s *IntervalSet[T]) All() iter.Seq[Range[T]] {
+	return func(yield func(Range[T]) bool) {
+		for _, iv := range s.data {
+			if !yield(iv) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIntervalSetHalfOpen(t *testing.T) {
+	s := NewIntervalSet(Range[int]{0, 5}, Range[int]{5, 8}, Range[int]{10, 12})
+	if got := slices.Collect(s.All()); !slices.Equal(got, []Range[int]{{0, 8}, {10, 12}}) {
+		t.Fatalf("ranges = %v", got)
+	}
+	for r := range s.All() {
+		if r.Contains(r.Hi) || !r.Contains(r.Lo) {
+			t.Errorf("%v does not exclude its end", r)
+		}
+	}
+	if s.Contains(8) || !s.Contains(7) || s.Contains(12) {
+		t.Error("Contains does not exclude the range ends")
+	}
+	if (Range[int]{0, 5}).Overlaps(Range[int]{5, 8}) || !(Range[int]{0, 6}).Overlaps(Range[int]{5, 8}) {
+		t.Error("touching ranges overlap")
+	}
+	c := s.Complement(Range[int]{-2, 11})
+	if got := slices.Collect(c.All()); !slices.Equal(got, []Range[int]{{-2, 0}, {8, 10}}) {
+		t.Errorf("complement = %v", got)
+	}
+	if s.Complement(Range[int]{3, 3}).Len() != 0 {
+		t.Error("complement within empty bounds is not empty")
+	}
+}
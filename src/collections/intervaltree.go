@@ -0,0 +1,230 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Interval is the closed interval [Lo, Hi]
+type Interval[T cmp.Ordered] struct {
+	Lo, Hi T
+}
+
+// Overlaps reports whether both intervals share at least one point
+func (iv Interval[T]) Overlaps(other Interval[T]) bool {
+	return iv.Lo <= other.Hi && other.Lo <= iv.Hi
+}
+
+// Contains reports whether p lies within the interval
+func (iv Interval[T]) Contains(p T) bool {
+	return iv.Lo <= p && p <= iv.Hi
+}
+
+func (iv Interval[T]) normalize() Interval[T] {
+	if iv.Lo > iv.Hi {
+		iv.Lo, iv.Hi = iv.Hi, iv.Lo
+	}
+	return iv
+}
+
+func compareIntervals[T cmp.Ordered](a, b Interval[T]) int {
+	if c := cmp.Compare(a.Lo, b.Lo); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Hi, b.Hi)
+}
+
+// IntervalTree maps intervals to values and finds all intervals overlapping a point or interval.
+// It is an AVL tree ordered by interval start where each node also stores the largest end in its subtree.
+type IntervalTree[T cmp.Ordered, V any] struct {
+	root *itNode[T, V]
+	size int
+}
+
+type itNode[T cmp.Ordered, V any] struct {
+	iv          Interval[T]
+	value       V
+	left, right *itNode[T, V]
+	height      int
+	maxHi       T
+}
+
+func NewIntervalTree[T cmp.Ordered, V any]() *IntervalTree[T, V] {
+	return &IntervalTree[T, V]{}
+}
+
+func (n *itNode[T, V]) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *itNode[T, V]) update() {
+	n.height = 1 + max(n.left.h(), n.right.h())
+	n.maxHi = n.iv.Hi
+	if n.left != nil {
+		n.maxHi = max(n.maxHi, n.left.maxHi)
+	}
+	if n.right != nil {
+		n.maxHi = max(n.maxHi, n.right.maxHi)
+	}
+}
+
+func (n *itNode[T, V]) rotateLeft() *itNode[T, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	n.update()
+	x.update()
+	return x
+}
+
+func (n *itNode[T, V]) rotateRight() *itNode[T, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	n.update()
+	x.update()
+	return x
+}
+
+func (n *itNode[T, V]) rebalance() *itNode[T, V] {
+	n.update()
+	switch b := n.left.h() - n.right.h(); {
+	case b > 1:
+		if n.left.left.h() < n.left.right.h() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case b < -1:
+		if n.right.right.h() < n.right.left.h() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// Insert stores value for iv, replacing the value of an equal interval. Lo and Hi are swapped if Lo > Hi.
+func (t *IntervalTree[T, V]) Insert(iv Interval[T], value V) {
+	t.root = t.insert(t.root, iv.normalize(), value)
+}
+
+func (t *IntervalTree[T, V]) insert(n *itNode[T, V], iv Interval[T], value V) *itNode[T, V] {
+	if n == nil {
+		t.size++
+		return &itNode[T, V]{iv: iv, value: value, height: 1, maxHi: iv.Hi}
+	}
+	switch c := compareIntervals(iv, n.iv); {
+	case c < 0:
+		n.left = t.insert(n.left, iv, value)
+	case c > 0:
+		n.right = t.insert(n.right, iv, value)
+	default:
+		n.value = value
+		return n
+	}
+	return n.rebalance()
+}
+
+// Get returns the value stored for exactly iv
+func (t *IntervalTree[T, V]) Get(iv Interval[T]) (V, bool) {
+	iv = iv.normalize()
+	for n := t.root; n != nil; {
+		switch c := compareIntervals(iv, n.iv); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes iv and reports whether it was present
+func (t *IntervalTree[T, V]) Delete(iv Interval[T]) bool {
+	size := t.size
+	t.root = t.delete(t.root, iv.normalize())
+	return t.size < size
+}
+
+func (t *IntervalTree[T, V]) delete(n *itNode[T, V], iv Interval[T]) *itNode[T, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := compareIntervals(iv, n.iv); {
+	case c < 0:
+		n.left = t.delete(n.left, iv)
+	case c > 0:
+		n.right = t.delete(n.right, iv)
+	default:
+		if n.left == nil || n.right == nil {
+			t.size--
+			if n.left != nil {
+				return n.left
+			}
+			return n.right
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.iv, n.value = successor.iv, successor.value
+		n.right = t.delete(n.right, successor.iv)
+	}
+	return n.rebalance()
+}
+
+func (t *IntervalTree[T, V]) Len() int {
+	return t.size
+}
+
+func (t *IntervalTree[T, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Stab returns an iterator over all intervals containing p, ordered by start
+func (t *IntervalTree[T, V]) Stab(p T) iter.Seq2[Interval[T], V] {
+	return t.Overlapping(Interval[T]{p, p})
+}
+
+// Overlapping returns an iterator over all intervals overlapping iv, ordered by start
+func (t *IntervalTree[T, V]) Overlapping(iv Interval[T]) iter.Seq2[Interval[T], V] {
+	iv = iv.normalize()
+	return func(yield func(Interval[T], V) bool) {
+		t.overlapping(t.root, iv, yield)
+	}
+}
+
+func (t *IntervalTree[T, V]) overlapping(n *itNode[T, V], iv Interval[T], yield func(Interval[T], V) bool) bool {
+	// No interval in this subtree ends late enough
+	if n == nil || n.maxHi < iv.Lo {
+		return true
+	}
+	if !t.overlapping(n.left, iv, yield) {
+		return false
+	}
+	// All intervals to the right start after iv ends
+	if n.iv.Lo > iv.Hi {
+		return true
+	}
+	if n.iv.Overlaps(iv) && !yield(n.iv, n.value) {
+		return false
+	}
+	return t.overlapping(n.right, iv, yield)
+}
+
+// All returns an iterator over all intervals ordered by start and then end
+func (t *IntervalTree[T, V]) All() iter.Seq2[Interval[T], V] {
+	return func(yield func(Interval[T], V) bool) {
+		t.all(t.root, yield)
+	}
+}
+
+func (t *IntervalTree[T, V]) all(n *itNode[T, V], yield func(Interval[T], V) bool) bool {
+	return n == nil || (t.all(n.left, yield) && yield(n.iv, n.value) && t.all(n.right, yield))
+}
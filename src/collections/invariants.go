@@ -0,0 +1,274 @@
+package collections
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// ErrInvariant is wrapped by all errors returned from Validate methods
+var ErrInvariant = errors.New("invariant violated")
+
+// Validator is implemented by the structures that can check their internal invariants
+type Validator interface {
+	Validate() error
+	CheckInvariants()
+}
+
+// checkInvariants implements the CheckInvariants methods, so corruption surfaces immediately in tests and fuzzing
+func checkInvariants(v Validator) {
+	if err := v.Validate(); err != nil {
+		panic(err)
+	}
+}
+
+func invariantf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvariant, fmt.Sprintf(format, args...))
+}
+
+// checkSorted reports an error unless keys are strictly ascending
+func checkSorted[K cmp.Ordered](keys []K) error {
+	for i := 1; i < len(keys); i++ {
+		if keys[i-1] >= keys[i] {
+			return invariantf("keys %v and %v out of order", keys[i-1], keys[i])
+		}
+	}
+	return nil
+}
+
+// opMap is the API shared by the sorted maps that RunMapOps drives
+type opMap interface {
+	Validator
+	Get(key int) (int, bool)
+	Set(key, value int)
+	Delete(key int) bool
+	Len() int
+}
+
+// RunMapOps decodes ops into a sequence of Set, Delete and Get calls on m, compares every result with a
+// built-in map and validates m after each step. It is meant to be called from fuzz targets with the
+// fuzzer's input, such as RunMapOps(NewTreeMap[int, int](), data).
+func RunMapOps(m opMap, ops []byte) error {
+	ref := make(map[int]int)
+	for i := 0; i+1 < len(ops); i += 2 {
+		op, key := ops[i]%3, int(ops[i+1])
+		switch op {
+		case 0:
+			m.Set(key, i)
+			ref[key] = i
+		case 1:
+			_, want := ref[key]
+			delete(ref, key)
+			if got := m.Delete(key); got != want {
+				return fmt.Errorf("step %d: Delete(%d) = %v, want %v", i/2, key, got, want)
+			}
+		case 2:
+			want, wantOk := ref[key]
+			if got, ok := m.Get(key); got != want || ok != wantOk {
+				return fmt.Errorf("step %d: Get(%d) = %d, %v, want %d, %v", i/2, key, got, ok, want, wantOk)
+			}
+		}
+		if m.Len() != len(ref) {
+			return fmt.Errorf("step %d: Len() = %d, want %d", i/2, m.Len(), len(ref))
+		}
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i/2, err)
+		}
+	}
+	return nil
+}
+
+// opHeap is the API shared by the heaps that RunHeapOps drives
+type opHeap interface {
+	Validator
+	Push(value int)
+	Pop() (int, bool)
+	Len() int
+}
+
+// RunHeapOps decodes ops into Push and Pop calls on h, which must pop the smallest element first,
+// checks the popped elements against a sorted reference and validates h after each step
+func RunHeapOps(h opHeap, ops []byte) error {
+	var ref []int
+	for i, b := range ops {
+		if b%2 == 0 {
+			h.Push(int(b))
+			ref = append(ref, int(b))
+		} else {
+			got, ok := h.Pop()
+			if ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: Pop reported %v with %d elements", i, ok, len(ref))
+			}
+			if ok {
+				j := slices.Index(ref, slices.Min(ref))
+				if got != ref[j] {
+					return fmt.Errorf("step %d: Pop() = %d, want %d", i, got, ref[j])
+				}
+				ref = slices.Delete(ref, j, j+1)
+			}
+		}
+		if h.Len() != len(ref) {
+			return fmt.Errorf("step %d: Len() = %d, want %d", i, h.Len(), len(ref))
+		}
+		if err := h.Validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// RunDequeOps decodes ops into pushes and pops at both ends of d, compares them with a slice and
+// validates d after each step
+func RunDequeOps(d *Deque[int], ops []byte) error {
+	var ref []int
+	for i, b := range ops {
+		var (
+			got, want int
+			ok        bool
+		)
+		switch b % 4 {
+		case 0:
+			d.PushFront(int(b))
+			ref = slices.Insert(ref, 0, int(b))
+		case 1:
+			d.PushBack(int(b))
+			ref = append(ref, int(b))
+		case 2:
+			if got, ok = d.PopFront(); ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: PopFront reported %v with %d elements", i, ok, len(ref))
+			}
+			if ok {
+				want, ref = ref[0], ref[1:]
+			}
+		case 3:
+			if got, ok = d.PopBack(); ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: PopBack reported %v with %d elements", i, ok, len(ref))
+			}
+			if ok {
+				want, ref = ref[len(ref)-1], ref[:len(ref)-1]
+			}
+		}
+		if got != want {
+			return fmt.Errorf("step %d: popped %d, want %d", i, got, want)
+		}
+		if !slices.Equal(slices.Collect(d.All()), ref) {
+			return fmt.Errorf("step %d: deque holds %v, want %v", i, slices.Collect(d.All()), ref)
+		}
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// RunStackOps decodes ops into Push and Pop calls on s, compares them with a slice and validates s after each step
+func RunStackOps(s *Stack[int], ops []byte) error {
+	var ref []int
+	for i, b := range ops {
+		if b%2 == 0 {
+			s.Push(int(b))
+			ref = append(ref, int(b))
+		} else {
+			got, ok := s.Pop()
+			if ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: Pop reported %v with %d elements", i, ok, len(ref))
+			}
+			if ok {
+				if want := ref[len(ref)-1]; got != want {
+					return fmt.Errorf("step %d: Pop() = %d, want %d", i, got, want)
+				}
+				ref = ref[:len(ref)-1]
+			}
+		}
+		if !slices.Equal(slices.Collect(s.All()), ref) {
+			return fmt.Errorf("step %d: stack holds %v, want %v", i, slices.Collect(s.All()), ref)
+		}
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// RunMinMaxHeapOps decodes ops into Push, PopMin and PopMax calls on h, checks the popped elements
+// against a sorted reference and validates h after each step
+func RunMinMaxHeapOps(h *MinMaxHeap[int], ops []byte) error {
+	var ref []int
+	for i, b := range ops {
+		var (
+			got, want int
+			ok        bool
+		)
+		switch b % 3 {
+		case 0:
+			h.Push(int(b))
+			ref = append(ref, int(b))
+			slices.Sort(ref)
+		case 1:
+			if got, ok = h.PopMin(); ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: PopMin reported %v with %d elements", i, ok, len(ref))
+			}
+			if ok {
+				want, ref = ref[0], ref[1:]
+			}
+		case 2:
+			if got, ok = h.PopMax(); ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: PopMax reported %v with %d elements", i, ok, len(ref))
+			}
+			if ok {
+				want, ref = ref[len(ref)-1], ref[:len(ref)-1]
+			}
+		}
+		if got != want {
+			return fmt.Errorf("step %d: popped %d, want %d", i, got, want)
+		}
+		if h.Len() != len(ref) {
+			return fmt.Errorf("step %d: Len() = %d, want %d", i, h.Len(), len(ref))
+		}
+		if err := h.Validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// RunIndexedPriorityQueueOps decodes ops into Push, Pop and Remove calls on q, compares them with a map
+// from keys to priorities and validates q after each step. Pop may return any key of the lowest priority.
+func RunIndexedPriorityQueueOps(q *IndexedPriorityQueue[int, int], ops []byte) error {
+	ref := make(map[int]int)
+	for i := 0; i+2 < len(ops); i += 3 {
+		op, key, prio := ops[i]%3, int(ops[i+1]%16), int(ops[i+2])
+		switch op {
+		case 0:
+			q.Push(key, prio)
+			ref[key] = prio
+		case 1:
+			got, gotPrio, ok := q.Pop()
+			if ok != (len(ref) > 0) {
+				return fmt.Errorf("step %d: Pop reported %v with %d elements", i/3, ok, len(ref))
+			}
+			if ok {
+				want := slices.Min(slices.Collect(maps.Values(ref)))
+				if p, queued := ref[got]; !queued || p != gotPrio || gotPrio != want {
+					return fmt.Errorf("step %d: Pop() = %d, %d, want a key with priority %d", i/3, got, gotPrio, want)
+				}
+				delete(ref, got)
+			}
+		case 2:
+			_, want := ref[key]
+			delete(ref, key)
+			if got := q.Remove(key); got != want {
+				return fmt.Errorf("step %d: Remove(%d) = %v, want %v", i/3, key, got, want)
+			}
+		}
+		if q.Len() != len(ref) {
+			return fmt.Errorf("step %d: Len() = %d, want %d", i/3, q.Len(), len(ref))
+		}
+		if err := q.Validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i/3, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// opSeeds are the seed corpus of every fuzz target: empty input, repeated keys, ascending and
+// descending runs, and a mix that exercises deletions of present and absent keys
+var opSeeds = [][]byte{
+	{},
+	{0, 1, 0, 1, 0, 1, 1, 1, 2, 1},
+	{0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 0, 8},
+	{0, 9, 0, 8, 0, 7, 0, 6, 0, 5, 0, 4, 0, 3, 0, 2},
+	{0, 5, 0, 3, 0, 8, 1, 3, 2, 3, 1, 5, 2, 8, 1, 9, 0, 3, 3, 8, 4, 4, 5, 5},
+}
+
+// fuzzOps registers the seed corpus and fails the target whenever run reports an error
+func fuzzOps(f *testing.F, run func(ops []byte) error) {
+	for _, seed := range opSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		if err := run(ops); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func intLess(a, b int) bool { return a < b }
+
+func FuzzTreeMap(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunMapOps(NewTreeMap[int, int](), ops) })
+}
+
+func FuzzAVLTree(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunMapOps(NewAVLTree[int, int](), ops) })
+}
+
+func FuzzSplayTree(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunMapOps(NewSplayTree[int, int](), ops) })
+}
+
+func FuzzTreap(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error {
+		t := NewTreap[int, int]()
+		t.SetRand(rand.New(rand.NewPCG(1, 2)))
+		return RunMapOps(t, ops)
+	})
+}
+
+func FuzzBTreeMap(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunMapOps(NewBTreeMap[int, int](2), ops) })
+}
+
+func FuzzPriorityQueue(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunHeapOps(NewPriorityQueue(intLess), ops) })
+}
+
+func FuzzPairingHeap(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunHeapOps(NewPairingHeap(intLess), ops) })
+}
+
+func FuzzMinMaxHeap(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunMinMaxHeapOps(NewMinMaxHeap[int](), ops) })
+}
+
+func FuzzIndexedPriorityQueue(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunIndexedPriorityQueueOps(NewIndexedPriorityQueue[int, int](), ops) })
+}
+
+func FuzzDeque(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunDequeOps(NewDeque[int](), ops) })
+}
+
+func FuzzStack(f *testing.F) {
+	fuzzOps(f, func(ops []byte) error { return RunStackOps(NewStack[int](), ops) })
+}
+
+func TestCheckInvariantsPanics(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	m.Set(1, 1)
+	m.CheckInvariants()
+	m.size = 5
+	defer func() {
+		if recover() == nil {
+			t.Error("CheckInvariants did not panic on a corrupted size")
+		}
+	}()
+	m.CheckInvariants()
+}
@@ -0,0 +1,174 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Rect is an axis-aligned box given by its minimum and maximum corner, bounds included
+type Rect struct {
+	Min, Max []float64
+}
+
+// KDTree indexes k-dimensional points for nearest-neighbor and range queries.
+// The coordinates of a point are read with the coord function passed to NewKDTree.
+type KDTree[P any] struct {
+	root  *kdNode[P]
+	dims  int
+	coord func(p P, axis int) float64
+	size  int
+}
+
+type kdNode[P any] struct {
+	point       P
+	left, right *kdNode[P]
+}
+
+// NewKDTree builds a balanced tree over points with dims dimensions
+func NewKDTree[P any](points []P, dims int, coord func(p P, axis int) float64) *KDTree[P] {
+	t := &KDTree[P]{dims: max(dims, 1), coord: coord, size: len(points)}
+	t.root = t.build(slices.Clone(points), 0)
+	return t
+}
+
+func (t *KDTree[P]) build(points []P, depth int) *kdNode[P] {
+	if len(points) == 0 {
+		return nil
+	}
+	axis := depth % t.dims
+	slices.SortFunc(points, func(a, b P) int {
+		return cmp.Compare(t.coord(a, axis), t.coord(b, axis))
+	})
+	mid := len(points) / 2
+	return &kdNode[P]{
+		point: points[mid],
+		left:  t.build(points[:mid], depth+1),
+		right: t.build(points[mid+1:], depth+1),
+	}
+}
+
+// Insert adds p without rebalancing the tree
+func (t *KDTree[P]) Insert(p P) {
+	link := &t.root
+	for depth := 0; *link != nil; depth++ {
+		axis := depth % t.dims
+		if t.coord(p, axis) < t.coord((*link).point, axis) {
+			link = &(*link).left
+		} else {
+			link = &(*link).right
+		}
+	}
+	*link = &kdNode[P]{point: p}
+	t.size++
+}
+
+func (t *KDTree[P]) Len() int {
+	return t.size
+}
+
+func (t *KDTree[P]) dist2(a, b P) float64 {
+	d := 0.0
+	for axis := range t.dims {
+		x := t.coord(a, axis) - t.coord(b, axis)
+		d += x * x
+	}
+	return d
+}
+
+// Nearest returns the point closest to q by Euclidean distance
+func (t *KDTree[P]) Nearest(q P) (P, bool) {
+	if res := t.KNearest(q, 1); len(res) > 0 {
+		return res[0], true
+	}
+	var zero P
+	return zero, false
+}
+
+type kdCandidate[P any] struct {
+	point P
+	dist  float64
+}
+
+// KNearest returns the k points closest to q ordered by increasing distance
+func (t *KDTree[P]) KNearest(q P, k int) []P {
+	if k <= 0 {
+		return nil
+	}
+	// Max-heap of the best candidates so far, the worst one on top
+	best := NewPriorityQueue(func(a, b kdCandidate[P]) bool { return a.dist > b.dist })
+	var search func(n *kdNode[P], depth int)
+	search = func(n *kdNode[P], depth int) {
+		if n == nil {
+			return
+		}
+		if d := t.dist2(q, n.point); best.Len() < k {
+			best.Push(kdCandidate[P]{n.point, d})
+		} else if worst, _ := best.Peek(); d < worst.dist {
+			best.Pop()
+			best.Push(kdCandidate[P]{n.point, d})
+		}
+		axis := depth % t.dims
+		diff := t.coord(q, axis) - t.coord(n.point, axis)
+		near, far := n.left, n.right
+		if diff >= 0 {
+			near, far = far, near
+		}
+		search(near, depth+1)
+		// The far side can only contain closer points if the splitting plane is closer than the worst candidate
+		if worst, _ := best.Peek(); best.Len() < k || diff*diff < worst.dist {
+			search(far, depth+1)
+		}
+	}
+	search(t.root, 0)
+
+	res := make([]P, best.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		c, _ := best.Pop()
+		res[i] = c.point
+	}
+	return res
+}
+
+// InRange returns an iterator over all points inside rect
+func (t *KDTree[P]) InRange(rect Rect) iter.Seq[P] {
+	return func(yield func(P) bool) {
+		t.inRange(t.root, 0, rect, yield)
+	}
+}
+
+func (t *KDTree[P]) inRange(n *kdNode[P], depth int, rect Rect, yield func(P) bool) bool {
+	if n == nil {
+		return true
+	}
+	axis := depth % t.dims
+	c := t.coord(n.point, axis)
+	if rect.Min[axis] <= c && !t.inRange(n.left, depth+1, rect, yield) {
+		return false
+	}
+	inside := true
+	for a := range t.dims {
+		if x := t.coord(n.point, a); x < rect.Min[a] || x > rect.Max[a] {
+			inside = false
+			break
+		}
+	}
+	if inside && !yield(n.point) {
+		return false
+	}
+	if rect.Max[axis] >= c {
+		return t.inRange(n.right, depth+1, rect, yield)
+	}
+	return true
+}
+
+// All returns an iterator over all points in tree order
+func (t *KDTree[P]) All() iter.Seq[P] {
+	return func(yield func(P) bool) {
+		t.all(t.root, yield)
+	}
+}
+
+func (t *KDTree[P]) all(n *kdNode[P], yield func(P) bool) bool {
+	return n == nil || (t.all(n.left, yield) && yield(n.point) && t.all(n.right, yield))
+}
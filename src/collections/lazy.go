@@ -0,0 +1,47 @@
+package collections
+
+import "sync"
+
+// Lazy holds a value that is computed on the first call to Value and then reused.
+// It is safe for concurrent use; concurrent first calls wait for a single computation.
+// The zero value has nothing to compute and yields the zero value of T.
+type Lazy[T any] struct {
+	value func() T
+}
+
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{value: sync.OnceValue(f)}
+}
+
+// Value returns the computed value. If the computation panicked, every call panics with the same value.
+func (l *Lazy[T]) Value() T {
+	if l.value == nil {
+		var zero T
+		return zero
+	}
+	return l.value()
+}
+
+// LazyResult is the fallible variant of Lazy. The error is memoized like the value, so a failed
+// computation is not retried. The zero value yields the zero value of T and a nil error.
+type LazyResult[T any] struct {
+	value func() (T, error)
+}
+
+func NewLazyResult[T any](f func() (T, error)) *LazyResult[T] {
+	return &LazyResult[T]{value: sync.OnceValues(f)}
+}
+
+// Value returns the computed value and error
+func (l *LazyResult[T]) Value() (T, error) {
+	if l.value == nil {
+		var zero T
+		return zero, nil
+	}
+	return l.value()
+}
+
+// Result returns the computed value and error as a Result
+func (l *LazyResult[T]) Result() Result[T] {
+	return ResultOf(l.Value())
+}
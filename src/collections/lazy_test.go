@@ -0,0 +1,43 @@
+package collections
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int64
+	l := NewLazy(func() int {
+		calls.Add(1)
+		return 42
+	})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := l.Value(); v != 42 {
+				t.Errorf("Value() = %d", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Errorf("computed %d times", n)
+	}
+}
+
+func TestLazyZeroValue(t *testing.T) {
+	var l Lazy[string]
+	if v := l.Value(); v != "" {
+		t.Errorf("zero Lazy yielded %q", v)
+	}
+	var r LazyResult[int]
+	if v, err := r.Value(); v != 0 || err != nil {
+		t.Errorf("zero LazyResult yielded %d, %v", v, err)
+	}
+	if res := r.Result(); !res.IsOk() {
+		t.Errorf("zero LazyResult.Result() = %v", res)
+	}
+}
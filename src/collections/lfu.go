@@ -0,0 +1,148 @@
+package collections
+
+import "iter"
+
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *DListElement[*lfuBucket[K, V]]
+}
+
+// lfuBucket holds all entries with the same use count, most recently used first
+type lfuBucket[K comparable, V any] struct {
+	freq    uint64
+	entries DList[*lfuEntry[K, V]]
+}
+
+// LFU is a fixed-capacity cache that evicts the least frequently used entry,
+// and among those the least recently used one. All operations are O(1).
+type LFU[K comparable, V any] struct {
+	capacity int
+	index    map[K]*DListElement[*lfuEntry[K, V]]
+	// buckets is ordered by ascending frequency
+	buckets DList[*lfuBucket[K, V]]
+	onEvict func(K, V)
+	stats   CacheStats
+}
+
+// NewLFU creates a cache holding at most capacity entries, which is at least 1
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	return &LFU[K, V]{capacity: max(capacity, 1), index: make(map[K]*DListElement[*lfuEntry[K, V]])}
+}
+
+// OnEvict registers fn to be called with every entry that is evicted to make room
+func (c *LFU[K, V]) OnEvict(fn func(key K, value V)) {
+	c.onEvict = fn
+}
+
+// touch moves the entry of e into the bucket for the next higher frequency
+func (c *LFU[K, V]) touch(e *DListElement[*lfuEntry[K, V]]) {
+	entry := e.Value
+	b := entry.bucket
+	next := b.Next()
+	if next == nil || next.Value.freq != b.Value.freq+1 {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{freq: b.Value.freq + 1}, b)
+	}
+	b.Value.entries.Remove(e)
+	if b.Value.entries.IsEmpty() {
+		c.buckets.Remove(b)
+	}
+	entry.bucket = next
+	c.index[entry.key] = next.Value.entries.PushFront(entry)
+}
+
+// Get returns the value for key and increments its use count
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.index[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without changing its use count or the statistics
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.index[key]; ok {
+		return e.Value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, evicting the least frequently used entry if the cache is full.
+// Updating an existing key counts as a use.
+func (c *LFU[K, V]) Put(key K, value V) {
+	if e, ok := c.index[key]; ok {
+		e.Value.value = value
+		c.touch(e)
+		return
+	}
+	if len(c.index) >= c.capacity {
+		b := c.buckets.Front()
+		old := b.Value.entries.Remove(b.Value.entries.Back())
+		if b.Value.entries.IsEmpty() {
+			c.buckets.Remove(b)
+		}
+		delete(c.index, old.key)
+		if c.onEvict != nil {
+			c.onEvict(old.key, old.value)
+		}
+	}
+	b := c.buckets.Front()
+	if b == nil || b.Value.freq != 1 {
+		b = c.buckets.PushFront(&lfuBucket[K, V]{freq: 1})
+	}
+	c.index[key] = b.Value.entries.PushFront(&lfuEntry[K, V]{key: key, value: value, bucket: b})
+}
+
+// Remove deletes key without calling the eviction callback and reports whether it was present
+func (c *LFU[K, V]) Remove(key K) bool {
+	e, ok := c.index[key]
+	if !ok {
+		return false
+	}
+	b := e.Value.bucket
+	b.Value.entries.Remove(e)
+	if b.Value.entries.IsEmpty() {
+		c.buckets.Remove(b)
+	}
+	delete(c.index, key)
+	return true
+}
+
+func (c *LFU[K, V]) Len() int {
+	return len(c.index)
+}
+
+func (c *LFU[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *LFU[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// Frequency returns the use count of key, or 0 if it is not cached
+func (c *LFU[K, V]) Frequency(key K) uint64 {
+	if e, ok := c.index[key]; ok {
+		return e.Value.bucket.Value.freq
+	}
+	return 0
+}
+
+// All returns an iterator over the entries from most to least frequently used
+func (c *LFU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for b := c.buckets.Back(); b != nil; b = b.Prev() {
+			for e := range b.Value.entries.All() {
+				if !yield(e.key, e.value) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package collections
+
+import "iter"
+
+// List is a singly linked list. Its nodes never move in memory, so handles to them stay valid.
+type List[T any] struct {
+	head  *ListNode[T]
+	tail  *ListNode[T]
+	size  int
+	nodes *Arena[ListNode[T]]
+}
+
+type ListNode[T any] struct {
+	Value T
+	next  *ListNode[T]
+}
+
+// Next returns the following node or nil at the end of the list
+func (n *ListNode[T]) Next() *ListNode[T] {
+	return n.next
+}
+
+func NewList[T any]() *List[T] {
+	return &List[T]{}
+}
+
+// NewListWithArena creates a list that allocates its nodes in blocks of blockSize.
+// Removed nodes are reused, so a node must not be used after it was removed.
+func NewListWithArena[T any](blockSize int) *List[T] {
+	return &List[T]{nodes: NewArena[ListNode[T]](blockSize)}
+}
+
+func (l *List[T]) newNode(value T, next *ListNode[T]) *ListNode[T] {
+	n := l.nodes.New()
+	n.Value, n.next = value, next
+	return n
+}
+
+func (l *List[T]) Front() *ListNode[T] {
+	return l.head
+}
+
+func (l *List[T]) Back() *ListNode[T] {
+	return l.tail
+}
+
+func (l *List[T]) PushFront(value T) *ListNode[T] {
+	n := l.newNode(value, l.head)
+	l.head = n
+	if l.tail == nil {
+		l.tail = n
+	}
+	l.size++
+	return n
+}
+
+func (l *List[T]) PushBack(value T) *ListNode[T] {
+	if l.tail == nil {
+		return l.PushFront(value)
+	}
+	n := l.newNode(value, nil)
+	l.tail.next = n
+	l.tail = n
+	l.size++
+	return n
+}
+
+// InsertAfter inserts value directly after node, which must belong to l
+func (l *List[T]) InsertAfter(node *ListNode[T], value T) *ListNode[T] {
+	n := l.newNode(value, node.next)
+	node.next = n
+	if l.tail == node {
+		l.tail = n
+	}
+	l.size++
+	return n
+}
+
+func (l *List[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	n := l.head
+	l.head = n.next
+	if l.head == nil {
+		l.tail = nil
+	}
+	n.next = nil
+	l.size--
+	value := n.Value
+	l.nodes.Free(n)
+	return value, true
+}
+
+// RemoveAfter removes the node following node in O(1) and reports whether there was one
+func (l *List[T]) RemoveAfter(node *ListNode[T]) bool {
+	n := node.next
+	if n == nil {
+		return false
+	}
+	node.next = n.next
+	if l.tail == n {
+		l.tail = node
+	}
+	n.next = nil
+	l.size--
+	l.nodes.Free(n)
+	return true
+}
+
+// Remove unlinks node from the list in O(n) and reports whether it was found
+func (l *List[T]) Remove(node *ListNode[T]) bool {
+	if l.head == nil {
+		return false
+	}
+	if l.head == node {
+		l.PopFront()
+		return true
+	}
+	for prev := l.head; prev.next != nil; prev = prev.next {
+		if prev.next == node {
+			return l.RemoveAfter(prev)
+		}
+	}
+	return false
+}
+
+// SpliceFront moves all nodes of other to the front of l in O(1), leaving other empty
+func (l *List[T]) SpliceFront(other *List[T]) {
+	if other == l || other.head == nil {
+		return
+	}
+	other.tail.next = l.head
+	l.head = other.head
+	if l.tail == nil {
+		l.tail = other.tail
+	}
+	l.size += other.size
+	other.head, other.tail, other.size = nil, nil, 0
+}
+
+// SpliceBack moves all nodes of other to the back of l in O(1), leaving other empty
+func (l *List[T]) SpliceBack(other *List[T]) {
+	if other == l || other.head == nil {
+		return
+	}
+	if l.tail == nil {
+		l.head = other.head
+	} else {
+		l.tail.next = other.head
+	}
+	l.tail = other.tail
+	l.size += other.size
+	other.head, other.tail, other.size = nil, nil, 0
+}
+
+func (l *List[T]) Len() int {
+	return l.size
+}
+
+func (l *List[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// All() Function returns an iterator over all values from front to back
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Nodes returns an iterator over all nodes from front to back
+func (l *List[T]) Nodes() iter.Seq[*ListNode[T]] {
+	return func(yield func(*ListNode[T]) bool) {
+		for n := l.head; n != nil; {
+			// Read next first so the yielded node may be removed
+			next := n.next
+			if !yield(n) {
+				return
+			}
+			n = next
+		}
+	}
+}
+
+// FormatWith renders the elements from head to tail according to f
+func (l *List[T]) FormatWith(f TextFormat) string {
+	return formatElements(sprintSeq(l.All()), l.Len(), f)
+}
+
+func (l *List[T]) String() string {
+	return l.FormatWith(TextFormat{})
+}
+
+func (l *List[T]) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
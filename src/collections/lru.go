@@ -0,0 +1,120 @@
+package collections
+
+import "iter"
+
+// LRU is a fixed-capacity cache that evicts the least recently used entry
+type LRU[K comparable, V any] struct {
+	capacity int
+	index    map[K]*DListElement[Pair[K, V]]
+	order    DList[Pair[K, V]]
+	onEvict  func(K, V)
+	stats    CacheStats
+}
+
+// NewLRU creates a cache holding at most capacity entries, which is at least 1
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{capacity: max(capacity, 1), index: make(map[K]*DListElement[Pair[K, V]])}
+}
+
+// OnEvict registers fn to be called with every entry that is evicted to make room
+func (c *LRU[K, V]) OnEvict(fn func(key K, value V)) {
+	c.onEvict = fn
+}
+
+// Get returns the value for key and marks it as most recently used
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.index[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+	return e.Value.Value, true
+}
+
+// Peek returns the value for key without changing its recency or the statistics
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.index[key]; ok {
+		return e.Value.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, evicting the least recently used entry if the cache is full
+func (c *LRU[K, V]) Put(key K, value V) {
+	if e, ok := c.index[key]; ok {
+		e.Value.Value = value
+		c.order.MoveToFront(e)
+		return
+	}
+	if len(c.index) >= c.capacity {
+		old := c.order.Remove(c.order.Back())
+		delete(c.index, old.Key)
+		if c.onEvict != nil {
+			c.onEvict(old.Key, old.Value)
+		}
+	}
+	c.index[key] = c.order.PushFront(Pair[K, V]{key, value})
+}
+
+// Remove deletes key without calling the eviction callback and reports whether it was present
+func (c *LRU[K, V]) Remove(key K) bool {
+	e, ok := c.index[key]
+	if ok {
+		c.order.Remove(e)
+		delete(c.index, key)
+	}
+	return ok
+}
+
+func (c *LRU[K, V]) Len() int {
+	return len(c.index)
+}
+
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *LRU[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// All returns an iterator over the entries from most to least recently used
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := c.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.Key, e.Value.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Marshal encodes the entries from most to least recently used with codec. The capacity is not encoded.
+func (c *LRU[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return appendElements(nil, c.Len(), c.order.All(), codec)
+}
+
+// Unmarshal replaces the entries of c with data produced by Marshal using the same codec, keeping their
+// recency. Entries beyond the capacity of c are dropped without calling the eviction callback.
+func (c *LRU[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	c.capacity = max(c.capacity, 1)
+	c.index = make(map[K]*DListElement[Pair[K, V]], min(len(entries), c.capacity))
+	c.order.init()
+	for _, e := range entries {
+		if len(c.index) == c.capacity {
+			break
+		}
+		if _, ok := c.index[e.Key]; !ok {
+			c.index[e.Key] = c.order.PushBack(e)
+		}
+	}
+	return nil
+}
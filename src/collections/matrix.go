@@ -0,0 +1,196 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"ROFT_examples/constraints"
+)
+
+// ErrDimensionMismatch is returned when combining matrices whose shapes do not fit
+var ErrDimensionMismatch = errors.New("matrix dimensions do not match")
+
+// Matrix is a dense matrix stored in row-major order
+type Matrix[T constraints.Number] struct {
+	rows, cols int
+	data       []T
+}
+
+// NewMatrix creates a rows x cols matrix of zeros
+func NewMatrix[T constraints.Number](rows, cols int) *Matrix[T] {
+	rows, cols = max(rows, 0), max(cols, 0)
+	return &Matrix[T]{rows: rows, cols: cols, data: make([]T, rows*cols)}
+}
+
+// MatrixFrom creates a matrix from a slice of rows, which must all have the same length
+func MatrixFrom[T constraints.Number](rows [][]T) (*Matrix[T], error) {
+	cols := 0
+	if len(rows) > 0 {
+		cols = len(rows[0])
+	}
+	m := NewMatrix[T](len(rows), cols)
+	for i, r := range rows {
+		if len(r) != cols {
+			return nil, ErrDimensionMismatch
+		}
+		copy(m.data[i*cols:], r)
+	}
+	return m, nil
+}
+
+// Identity creates the n x n identity matrix
+func Identity[T constraints.Number](n int) *Matrix[T] {
+	m := NewMatrix[T](n, n)
+	for i := range n {
+		m.data[i*n+i] = 1
+	}
+	return m
+}
+
+func (m *Matrix[T]) Rows() int {
+	return m.rows
+}
+
+func (m *Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// At returns the element in row i and column j
+func (m *Matrix[T]) At(i, j int) T {
+	m.check(i, j)
+	return m.data[i*m.cols+j]
+}
+
+func (m *Matrix[T]) Set(i, j int, value T) {
+	m.check(i, j)
+	m.data[i*m.cols+j] = value
+}
+
+func (m *Matrix[T]) check(i, j int) {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic(fmt.Sprintf("matrix: index (%d, %d) out of range for %dx%d matrix", i, j, m.rows, m.cols))
+	}
+}
+
+func (m *Matrix[T]) Clone() *Matrix[T] {
+	return &Matrix[T]{rows: m.rows, cols: m.cols, data: append([]T(nil), m.data...)}
+}
+
+// Add returns the element-wise sum of m and other
+func (m *Matrix[T]) Add(other *Matrix[T]) (*Matrix[T], error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, ErrDimensionMismatch
+	}
+	r := m.Clone()
+	for i, v := range other.data {
+		r.data[i] += v
+	}
+	return r, nil
+}
+
+// Scale returns m with every element multiplied by f
+func (m *Matrix[T]) Scale(f T) *Matrix[T] {
+	r := m.Clone()
+	for i := range r.data {
+		r.data[i] *= f
+	}
+	return r
+}
+
+// Mul returns the matrix product m * other
+func (m *Matrix[T]) Mul(other *Matrix[T]) (*Matrix[T], error) {
+	if m.cols != other.rows {
+		return nil, ErrDimensionMismatch
+	}
+	r := NewMatrix[T](m.rows, other.cols)
+	for i := range m.rows {
+		out := r.data[i*r.cols : (i+1)*r.cols]
+		// i-k-j loop order walks both operands row by row
+		for k, a := range m.data[i*m.cols : (i+1)*m.cols] {
+			for j, b := range other.data[k*other.cols : (k+1)*other.cols] {
+				out[j] += a * b
+			}
+		}
+	}
+	return r, nil
+}
+
+func (m *Matrix[T]) Transpose() *Matrix[T] {
+	r := NewMatrix[T](m.cols, m.rows)
+	for i := range m.rows {
+		for j := range m.cols {
+			r.data[j*r.cols+i] = m.data[i*m.cols+j]
+		}
+	}
+	return r
+}
+
+// Equal reports whether both matrices have the same shape and elements
+func (m *Matrix[T]) Equal(other *Matrix[T]) bool {
+	if m.rows != other.rows || m.cols != other.cols {
+		return false
+	}
+	for i, v := range m.data {
+		if other.data[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Row returns an iterator over the elements of row i
+func (m *Matrix[T]) Row(i int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for j := range m.cols {
+			if !yield(m.At(i, j)) {
+				return
+			}
+		}
+	}
+}
+
+// Col returns an iterator over the elements of column j
+func (m *Matrix[T]) Col(j int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range m.rows {
+			if !yield(m.At(i, j)) {
+				return
+			}
+		}
+	}
+}
+
+// AllRows returns an iterator over the row indices and rows. The slices alias the matrix.
+func (m *Matrix[T]) AllRows() iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		for i := range m.rows {
+			if !yield(i, m.data[i*m.cols:(i+1)*m.cols:(i+1)*m.cols]) {
+				return
+			}
+		}
+	}
+}
+
+// String formats the matrix with one row per line and right-aligned columns
+func (m *Matrix[T]) String() string {
+	cells := make([]string, len(m.data))
+	widths := make([]int, m.cols)
+	for i, v := range m.data {
+		cells[i] = fmt.Sprint(v)
+		widths[i%m.cols] = max(widths[i%m.cols], len(cells[i]))
+	}
+	var sb strings.Builder
+	for i := range m.rows {
+		sb.WriteByte('[')
+		for j := range m.cols {
+			if j > 0 {
+				sb.WriteByte(' ')
+			}
+			fmt.Fprintf(&sb, "%*s", widths[j], cells[i*m.cols+j])
+		}
+		sb.WriteString("]\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,182 @@
+package collections
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// MinMaxHeap is a double-ended priority queue with O(log n) access to both the smallest and the largest element
+type MinMaxHeap[T cmp.Ordered] struct {
+	data []T
+}
+
+func NewMinMaxHeap[T cmp.Ordered]() *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{}
+}
+
+// isMinLevel reports whether index i lies on an even level, where nodes are smaller than their descendants
+func isMinLevel(i int) bool {
+	return (bits.Len(uint(i+1))-1)%2 == 0
+}
+
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.data = append(h.data, value)
+	h.bubbleUp(len(h.data) - 1)
+}
+
+func (h *MinMaxHeap[T]) Min() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[0], true
+}
+
+func (h *MinMaxHeap[T]) Max() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[h.maxIndex()], true
+}
+
+func (h *MinMaxHeap[T]) PopMin() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(0), true
+}
+
+func (h *MinMaxHeap[T]) PopMax() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(h.maxIndex()), true
+}
+
+func (h *MinMaxHeap[T]) Len() int {
+	return len(h.data)
+}
+
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch {
+	case len(h.data) == 1:
+		return 0
+	case len(h.data) == 2 || h.data[1] >= h.data[2]:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	last := len(h.data) - 1
+	val := h.data[i]
+	h.data[i] = h.data[last]
+	var zero T
+	h.data[last] = zero
+	h.data = h.data[:last]
+	if i < last {
+		h.trickleDown(i)
+	}
+	return val
+}
+
+func (h *MinMaxHeap[T]) bubbleUp(i int) {
+	if i == 0 {
+		return
+	}
+	parent := (i - 1) / 2
+	if isMinLevel(i) {
+		if h.data[i] > h.data[parent] {
+			h.data[i], h.data[parent] = h.data[parent], h.data[i]
+			h.bubbleUpLevel(parent, false)
+		} else {
+			h.bubbleUpLevel(i, true)
+		}
+	} else {
+		if h.data[i] < h.data[parent] {
+			h.data[i], h.data[parent] = h.data[parent], h.data[i]
+			h.bubbleUpLevel(parent, true)
+		} else {
+			h.bubbleUpLevel(i, false)
+		}
+	}
+}
+
+// bubbleUpLevel moves i up through its grandparents, which lie on the same kind of level
+func (h *MinMaxHeap[T]) bubbleUpLevel(i int, minLevel bool) {
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if (minLevel && h.data[i] < h.data[grandparent]) || (!minLevel && h.data[i] > h.data[grandparent]) {
+			h.data[i], h.data[grandparent] = h.data[grandparent], h.data[i]
+			i = grandparent
+		} else {
+			return
+		}
+	}
+}
+
+func (h *MinMaxHeap[T]) trickleDown(i int) {
+	minLevel := isMinLevel(i)
+	// before reports whether a belongs closer to the root than b on the current kind of level
+	before := func(a, b T) bool {
+		if minLevel {
+			return a < b
+		}
+		return a > b
+	}
+	for {
+		// Find the extreme element among the children and grandchildren
+		m := -1
+		for _, c := range [6]int{2*i + 1, 2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if c < len(h.data) && (m < 0 || before(h.data[c], h.data[m])) {
+				m = c
+			}
+		}
+		if m < 0 || !before(h.data[m], h.data[i]) {
+			return
+		}
+		h.data[m], h.data[i] = h.data[i], h.data[m]
+		if m <= 2*i+2 {
+			// m is a direct child
+			return
+		}
+		if parent := (m - 1) / 2; before(h.data[parent], h.data[m]) {
+			h.data[m], h.data[parent] = h.data[parent], h.data[m]
+		}
+		i = m
+	}
+}
+
+// Validate checks that every element on a min level is no larger, and every element on a max level no smaller,
+// than all of its descendants. Comparing each element with its parent and grandparent is sufficient.
+func (h *MinMaxHeap[T]) Validate() error {
+	ordered := func(ancestor, i int) bool {
+		if isMinLevel(ancestor) {
+			return h.data[ancestor] <= h.data[i]
+		}
+		return h.data[ancestor] >= h.data[i]
+	}
+	for i := 1; i < len(h.data); i++ {
+		parent := (i - 1) / 2
+		if !ordered(parent, i) {
+			return invariantf("element %d is out of order with its parent", i)
+		}
+		if parent > 0 && !ordered((parent-1)/2, i) {
+			return invariantf("element %d is out of order with its grandparent", i)
+		}
+	}
+	return nil
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (h *MinMaxHeap[T]) CheckInvariants() {
+	checkInvariants(h)
+}
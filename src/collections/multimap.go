@@ -0,0 +1,103 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// MultiMap maps each key to a list of values
+type MultiMap[K comparable, V any] struct {
+	data map[K][]V
+	size int
+}
+
+func NewMultiMap[K comparable, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{data: make(map[K][]V)}
+}
+
+// Add appends values to the values of key
+func (m *MultiMap[K, V]) Add(key K, values ...V) {
+	m.data[key] = append(m.data[key], values...)
+	m.size += len(values)
+}
+
+// Get returns a copy of all values of key in insertion order
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return slices.Clone(m.data[key])
+}
+
+func (m *MultiMap[K, V]) Contains(key K) bool {
+	_, ok := m.data[key]
+	return ok
+}
+
+// RemoveAll removes key with all its values and returns how many values were removed
+func (m *MultiMap[K, V]) RemoveAll(key K) int {
+	n := len(m.data[key])
+	delete(m.data, key)
+	m.size -= n
+	return n
+}
+
+// RemoveFunc removes the values of key for which del returns true and returns how many were removed
+func (m *MultiMap[K, V]) RemoveFunc(key K, del func(V) bool) int {
+	values, ok := m.data[key]
+	if !ok {
+		return 0
+	}
+	kept := slices.DeleteFunc(values, del)
+	n := len(values) - len(kept)
+	if len(kept) == 0 {
+		delete(m.data, key)
+	} else {
+		m.data[key] = kept
+	}
+	m.size -= n
+	return n
+}
+
+// RemoveValue removes the first occurrence of value from the values of key and reports whether it was found
+func RemoveValue[K, V comparable](m *MultiMap[K, V], key K, value V) bool {
+	i := slices.Index(m.data[key], value)
+	if i < 0 {
+		return false
+	}
+	m.data[key] = slices.Delete(m.data[key], i, i+1)
+	if len(m.data[key]) == 0 {
+		delete(m.data, key)
+	}
+	m.size--
+	return true
+}
+
+// Len returns the total number of values over all keys
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *MultiMap[K, V]) KeyCount() int {
+	return len(m.data)
+}
+
+func (m *MultiMap[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(m.data)
+}
+
+// All() Function returns an iterator over every key-value pair, yielding a key once per value
+func (m *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, values := range m.data {
+			for _, v := range values {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Grouped returns an iterator over every key together with all of its values
+func (m *MultiMap[K, V]) Grouped() iter.Seq2[K, []V] {
+	return maps.All(m.data)
+}
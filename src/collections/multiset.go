@@ -0,0 +1,115 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+)
+
+// MultiSet is an unordered collection that tracks how often each element was added
+type MultiSet[T comparable] struct {
+	counts map[T]int
+	total  int
+}
+
+func NewMultiSet[T comparable](values ...T) *MultiSet[T] {
+	s := &MultiSet[T]{counts: make(map[T]int)}
+	for _, v := range values {
+		s.Add(v, 1)
+	}
+	return s
+}
+
+// Add adds n occurrences of value
+func (s *MultiSet[T]) Add(value T, n int) {
+	if n <= 0 {
+		return
+	}
+	s.counts[value] += n
+	s.total += n
+}
+
+// Remove removes up to n occurrences of value and returns how many were removed
+func (s *MultiSet[T]) Remove(value T, n int) int {
+	c := s.counts[value]
+	n = min(max(n, 0), c)
+	if n == c {
+		delete(s.counts, value)
+	} else {
+		s.counts[value] = c - n
+	}
+	s.total -= n
+	return n
+}
+
+func (s *MultiSet[T]) Count(value T) int {
+	return s.counts[value]
+}
+
+func (s *MultiSet[T]) Contains(value T) bool {
+	return s.counts[value] > 0
+}
+
+// Len returns the number of elements including duplicates
+func (s *MultiSet[T]) Len() int {
+	return s.total
+}
+
+// Distinct returns the number of distinct elements
+func (s *MultiSet[T]) Distinct() int {
+	return len(s.counts)
+}
+
+func (s *MultiSet[T]) IsEmpty() bool {
+	return s.total == 0
+}
+
+func (s *MultiSet[T]) Clone() *MultiSet[T] {
+	return &MultiSet[T]{counts: maps.Clone(s.counts), total: s.total}
+}
+
+// Union returns a new multiset where each element occurs as often as in whichever of s and other has more of it
+func (s *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
+	r := s.Clone()
+	for v, c := range other.counts {
+		if c > r.counts[v] {
+			r.Add(v, c-r.counts[v])
+		}
+	}
+	return r
+}
+
+// Intersect returns a new multiset where each element occurs as often as in whichever of s and other has less of it
+func (s *MultiSet[T]) Intersect(other *MultiSet[T]) *MultiSet[T] {
+	r := NewMultiSet[T]()
+	for v, c := range s.counts {
+		r.Add(v, min(c, other.counts[v]))
+	}
+	return r
+}
+
+// Sum returns a new multiset with the occurrences of s and other added up
+func (s *MultiSet[T]) Sum(other *MultiSet[T]) *MultiSet[T] {
+	r := s.Clone()
+	for v, c := range other.counts {
+		r.Add(v, c)
+	}
+	return r
+}
+
+// All() Function returns an iterator yielding every element as often as it occurs
+func (s *MultiSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, c := range s.counts {
+			for range c {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Counts returns an iterator over the distinct elements and their number of occurrences
+func (s *MultiSet[T]) Counts() iter.Seq2[T, int] {
+	return maps.All(s.counts)
+}
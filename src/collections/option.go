@@ -0,0 +1,79 @@
+package collections
+
+import "fmt"
+
+// Option holds either a value or nothing. The zero Option is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding v
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None returns an empty Option
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf converts the comma-ok idiom into an Option, so that OptionOf(s.Pop()) holds the popped element, if any
+func OptionOf[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// Get returns the value and true, or the zero value and false for None
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// OrElse returns the value, or def for None
+func (o Option[T]) OrElse(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+
+// OrElseFunc returns the value, or the result of def for None. def is only called when needed.
+func (o Option[T]) OrElseFunc(def func() T) T {
+	if !o.ok {
+		return def()
+	}
+	return o.value
+}
+
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MapOption applies f to the value of o, passing None through unchanged
+func MapOption[T, U any](o Option[T], f func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
+
+// FlatMapOption applies f to the value of o and returns its result, passing None through unchanged
+func FlatMapOption[T, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return f(o.value)
+}
@@ -0,0 +1,162 @@
+package collections
+
+import "iter"
+
+// OrderedMap is a map that remembers the order in which keys were first inserted.
+// The zero value is an empty map ready to use.
+type OrderedMap[K comparable, V any] struct {
+	index map[K]*DListElement[Pair[K, V]]
+	order DList[Pair[K, V]]
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{index: make(map[K]*DListElement[Pair[K, V]])}
+}
+
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.index[key]; ok {
+		return e.Value.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set stores value for key. A new key is appended at the end, an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.index[key]; ok {
+		e.Value.Value = value
+		return
+	}
+	if m.index == nil {
+		m.index = make(map[K]*DListElement[Pair[K, V]])
+	}
+	m.index[key] = m.order.PushBack(Pair[K, V]{key, value})
+}
+
+// Delete removes key and reports whether it was present
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.index[key]
+	if ok {
+		m.order.Remove(e)
+		delete(m.index, key)
+	}
+	return ok
+}
+
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.index[key]
+	return ok
+}
+
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+// Oldest returns the entry that was inserted first
+func (m *OrderedMap[K, V]) Oldest() (Pair[K, V], bool) {
+	if e := m.order.Front(); e != nil {
+		return e.Value, true
+	}
+	return Pair[K, V]{}, false
+}
+
+// Newest returns the entry that was inserted last
+func (m *OrderedMap[K, V]) Newest() (Pair[K, V], bool) {
+	if e := m.order.Back(); e != nil {
+		return e.Value, true
+	}
+	return Pair[K, V]{}, false
+}
+
+// All() Function returns an iterator over all entries in insertion order
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range m.order.All() {
+			if !yield(p.Key, p.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all entries in reverse insertion order
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range m.order.Backward() {
+			if !yield(p.Key, p.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// GobEncode encodes the entries in insertion order
+func (m *OrderedMap[K, V]) GobEncode() ([]byte, error) {
+	return gobEncodeSeq(m.order.All())
+}
+
+// GobDecode replaces the contents of m with data produced by GobEncode
+func (m *OrderedMap[K, V]) GobDecode(data []byte) error {
+	entries, err := gobDecodeSlice[Pair[K, V]](data)
+	if err != nil {
+		return err
+	}
+	m.index = make(map[K]*DListElement[Pair[K, V]], len(entries))
+	m.order.init()
+	for _, e := range entries {
+		m.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// Marshal encodes the entries in insertion order with codec
+func (m *OrderedMap[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return appendElements(nil, m.Len(), m.order.All(), codec)
+}
+
+// Unmarshal replaces the contents of m with data produced by Marshal using the same codec
+func (m *OrderedMap[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	m.index = make(map[K]*DListElement[Pair[K, V]], len(entries))
+	m.order.init()
+	for _, e := range entries {
+		m.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// FormatWith renders the elements as key:value in insertion order according to f
+func (m *OrderedMap[K, V]) FormatWith(f TextFormat) string {
+	return formatElements(sprintEntries(m.All()), m.Len(), f)
+}
+
+func (m *OrderedMap[K, V]) String() string {
+	return m.FormatWith(TextFormat{})
+}
+
+func (m *OrderedMap[K, V]) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
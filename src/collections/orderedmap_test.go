@@ -0,0 +1,25 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	var m OrderedMap[string, int]
+	if _, ok := m.Oldest(); ok || m.Delete("a") || m.Len() != 0 {
+		t.Error("zero map is not empty")
+	}
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+	if m.Len() != 2 {
+		t.Errorf("Len() = %d after Set, want 2", m.Len())
+	}
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, []string{"b", "a"}) {
+		t.Errorf("keys in insertion order = %v", got)
+	}
+	if v, _ := m.Get("b"); v != 3 {
+		t.Errorf(`Get("b") = %d, want 3`, v)
+	}
+}
@@ -0,0 +1,118 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// OrderedSet is a set that keeps its elements sorted, backed by a sorted slice
+type OrderedSet[T cmp.Ordered] struct {
+	data []T
+}
+
+func NewOrderedSet[T cmp.Ordered](values ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{data: slices.Clone(values)}
+	slices.Sort(s.data)
+	s.data = slices.Compact(s.data)
+	return s
+}
+
+// Add inserts value and reports whether it was not already present
+func (s *OrderedSet[T]) Add(value T) bool {
+	i, found := slices.BinarySearch(s.data, value)
+	if found {
+		return false
+	}
+	s.data = slices.Insert(s.data, i, value)
+	return true
+}
+
+// Remove deletes value and reports whether it was present
+func (s *OrderedSet[T]) Remove(value T) bool {
+	i, found := slices.BinarySearch(s.data, value)
+	if found {
+		s.data = slices.Delete(s.data, i, i+1)
+	}
+	return found
+}
+
+func (s *OrderedSet[T]) Contains(value T) bool {
+	_, found := slices.BinarySearch(s.data, value)
+	return found
+}
+
+func (s *OrderedSet[T]) Len() int {
+	return len(s.data)
+}
+
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+func (s *OrderedSet[T]) Min() (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.data[0], true
+}
+
+func (s *OrderedSet[T]) Max() (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.data[len(s.data)-1], true
+}
+
+// Floor returns the largest element less than or equal to value
+func (s *OrderedSet[T]) Floor(value T) (T, bool) {
+	i, found := slices.BinarySearch(s.data, value)
+	if found {
+		return s.data[i], true
+	}
+	if i == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.data[i-1], true
+}
+
+// Ceiling returns the smallest element greater than or equal to value
+func (s *OrderedSet[T]) Ceiling(value T) (T, bool) {
+	i, _ := slices.BinarySearch(s.data, value)
+	if i == len(s.data) {
+		var zero T
+		return zero, false
+	}
+	return s.data[i], true
+}
+
+// All() Function returns an iterator over all elements in ascending order
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return slices.Values(s.data)
+}
+
+// Backward returns an iterator over all elements in descending order
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range slices.Backward(s.data) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the elements in [lo, hi) in ascending order
+func (s *OrderedSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i, _ := slices.BinarySearch(s.data, lo)
+		for ; i < len(s.data) && s.data[i] < hi; i++ {
+			if !yield(s.data[i]) {
+				return
+			}
+		}
+	}
+}
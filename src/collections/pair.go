@@ -0,0 +1,40 @@
+package collections
+
+// Pair is a key-value pair
+type Pair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// Triple groups three values of possibly different types
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+func TripleOf[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a, b, c}
+}
+
+// Unpack returns the fields in order, for use in multi-value assignments
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// Quad groups four values of possibly different types
+type Quad[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+func QuadOf[A, B, C, D any](a A, b B, c C, d D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{a, b, c, d}
+}
+
+// Unpack returns the fields in order, for use in multi-value assignments
+func (q Quad[A, B, C, D]) Unpack() (A, B, C, D) {
+	return q.First, q.Second, q.Third, q.Fourth
+}
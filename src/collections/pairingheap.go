@@ -0,0 +1,135 @@
+package collections
+
+// PairingHeap is a heap supporting O(1) Push and Meld, popping the element with the highest priority first
+type PairingHeap[T any] struct {
+	root *pairingNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+}
+
+// NewPairingHeap creates a heap where less(a, b) means a has a higher priority than b
+func NewPairingHeap[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{less: less}
+}
+
+func (h *PairingHeap[T]) link(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+	b.sibling = a.child
+	a.child = b
+	return a
+}
+
+func (h *PairingHeap[T]) Push(value T) {
+	h.root = h.link(h.root, &pairingNode[T]{value: value})
+	h.size++
+}
+
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+func (h *PairingHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	top := h.root.value
+	h.root = h.mergePairs(h.root.child)
+	h.size--
+	return top, true
+}
+
+// mergePairs links the children pairwise from left to right and then folds the pairs from right to left
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var pairs []*pairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling = nil
+		}
+		a.sibling = nil
+		pairs = append(pairs, h.link(a, b))
+	}
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
+
+// Meld moves all elements of other into h in O(1), leaving other empty.
+// Both heaps must use the same ordering.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if h == other {
+		return
+	}
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+func (h *PairingHeap[T]) Len() int {
+	return h.size
+}
+
+func (h *PairingHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Validate checks that no element has a higher priority than its parent and the cached size
+func (h *PairingHeap[T]) Validate() error {
+	if h.root != nil && h.root.sibling != nil {
+		return invariantf("root has siblings")
+	}
+	n, err := h.validate(h.root)
+	if err == nil && n != h.size {
+		err = invariantf("size %d, but %d nodes", h.size, n)
+	}
+	return err
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (h *PairingHeap[T]) CheckInvariants() {
+	checkInvariants(h)
+}
+
+// validate returns the number of nodes in the subtree rooted at n
+func (h *PairingHeap[T]) validate(n *pairingNode[T]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	count := 1
+	for c := n.child; c != nil; c = c.sibling {
+		if h.less(c.value, n.value) {
+			return 0, invariantf("child has a higher priority than its parent")
+		}
+		cc, err := h.validate(c)
+		if err != nil {
+			return 0, err
+		}
+		count += cc
+	}
+	return count, nil
+}
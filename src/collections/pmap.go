@@ -0,0 +1,225 @@
+package collections
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+)
+
+const hamtBits = 5
+
+var hamtSeed = maphash.MakeSeed()
+
+// PersistentMap is an immutable hash array mapped trie. Set and Delete return new maps that share
+// all unchanged nodes with the original, so a map can be handed to other goroutines without locking.
+// The zero value is an empty map.
+type PersistentMap[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+}
+
+type hamtNode[K comparable, V any] struct {
+	bitmap  uint32
+	entries []hamtEntry[K, V]
+}
+
+// hamtEntry is either a sub node or a leaf with all entries of one hash
+type hamtEntry[K comparable, V any] struct {
+	sub  *hamtNode[K, V]
+	hash uint64
+	kvs  []Pair[K, V]
+}
+
+func NewPersistentMap[K comparable, V any]() *PersistentMap[K, V] {
+	return &PersistentMap[K, V]{}
+}
+
+func (m *PersistentMap[K, V]) Len() int {
+	return m.size
+}
+
+func hamtIndex(hash uint64, shift uint) (uint32, uint32) {
+	bit := uint32(1) << ((hash >> shift) & (1<<hamtBits - 1))
+	return bit, bit - 1
+}
+
+func (m *PersistentMap[K, V]) Get(key K) (V, bool) {
+	hash := maphash.Comparable(hamtSeed, key)
+	n := m.root
+	for shift := uint(0); n != nil; shift += hamtBits {
+		bit, below := hamtIndex(hash, shift)
+		if n.bitmap&bit == 0 {
+			break
+		}
+		e := &n.entries[bits.OnesCount32(n.bitmap&below)]
+		if e.sub != nil {
+			n = e.sub
+			continue
+		}
+		if e.hash == hash {
+			for _, kv := range e.kvs {
+				if kv.Key == key {
+					return kv.Value, true
+				}
+			}
+		}
+		break
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *PersistentMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Set returns a map in which key is mapped to value
+func (m *PersistentMap[K, V]) Set(key K, value V) *PersistentMap[K, V] {
+	hash := maphash.Comparable(hamtSeed, key)
+	leaf := hamtEntry[K, V]{hash: hash, kvs: []Pair[K, V]{{key, value}}}
+	root, added := hamtSet(m.root, 0, leaf)
+	size := m.size
+	if added {
+		size++
+	}
+	return &PersistentMap[K, V]{root: root, size: size}
+}
+
+func hamtSet[K comparable, V any](n *hamtNode[K, V], shift uint, leaf hamtEntry[K, V]) (*hamtNode[K, V], bool) {
+	if n == nil {
+		n = &hamtNode[K, V]{}
+	}
+	bit, below := hamtIndex(leaf.hash, shift)
+	pos := bits.OnesCount32(n.bitmap & below)
+	c := &hamtNode[K, V]{bitmap: n.bitmap | bit}
+	if n.bitmap&bit == 0 {
+		c.entries = make([]hamtEntry[K, V], 0, len(n.entries)+1)
+		c.entries = append(c.entries, n.entries[:pos]...)
+		c.entries = append(c.entries, leaf)
+		c.entries = append(c.entries, n.entries[pos:]...)
+		return c, true
+	}
+
+	c.entries = append([]hamtEntry[K, V](nil), n.entries...)
+	e := n.entries[pos]
+	switch {
+	case e.sub != nil:
+		sub, added := hamtSet(e.sub, shift+hamtBits, leaf)
+		c.entries[pos] = hamtEntry[K, V]{sub: sub}
+		return c, added
+	case e.hash == leaf.hash:
+		kv := leaf.kvs[0]
+		kvs := append([]Pair[K, V](nil), e.kvs...)
+		for i := range kvs {
+			if kvs[i].Key == kv.Key {
+				kvs[i].Value = kv.Value
+				c.entries[pos].kvs = kvs
+				return c, false
+			}
+		}
+		c.entries[pos].kvs = append(kvs, kv)
+		return c, true
+	}
+	// Two different hashes share this slot, push both one level down
+	sub, _ := hamtSet(nil, shift+hamtBits, e)
+	sub, _ = hamtSet(sub, shift+hamtBits, leaf)
+	c.entries[pos] = hamtEntry[K, V]{sub: sub}
+	return c, true
+}
+
+// Delete returns a map without key
+func (m *PersistentMap[K, V]) Delete(key K) *PersistentMap[K, V] {
+	root, removed := hamtDelete(m.root, 0, maphash.Comparable(hamtSeed, key), key)
+	if !removed {
+		return m
+	}
+	return &PersistentMap[K, V]{root: root, size: m.size - 1}
+}
+
+func hamtDelete[K comparable, V any](n *hamtNode[K, V], shift uint, hash uint64, key K) (*hamtNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	bit, below := hamtIndex(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	pos := bits.OnesCount32(n.bitmap & below)
+	e := n.entries[pos]
+	var replacement hamtEntry[K, V]
+	switch {
+	case e.sub != nil:
+		sub, removed := hamtDelete(e.sub, shift+hamtBits, hash, key)
+		if !removed {
+			return n, false
+		}
+		switch {
+		case sub == nil:
+			return hamtWithout(n, bit, pos), true
+		case len(sub.entries) == 1 && sub.entries[0].sub == nil:
+			// Pull a lone leaf up into this node
+			replacement = sub.entries[0]
+		default:
+			replacement = hamtEntry[K, V]{sub: sub}
+		}
+	case e.hash == hash:
+		i := -1
+		for j, kv := range e.kvs {
+			if kv.Key == key {
+				i = j
+			}
+		}
+		if i < 0 {
+			return n, false
+		}
+		if len(e.kvs) == 1 {
+			return hamtWithout(n, bit, pos), true
+		}
+		kvs := append(append([]Pair[K, V](nil), e.kvs[:i]...), e.kvs[i+1:]...)
+		replacement = hamtEntry[K, V]{hash: hash, kvs: kvs}
+	default:
+		return n, false
+	}
+	c := &hamtNode[K, V]{bitmap: n.bitmap, entries: append([]hamtEntry[K, V](nil), n.entries...)}
+	c.entries[pos] = replacement
+	return c, true
+}
+
+// hamtWithout returns a copy of n without the entry at pos, or nil if it was the only one
+func hamtWithout[K comparable, V any](n *hamtNode[K, V], bit uint32, pos int) *hamtNode[K, V] {
+	if len(n.entries) == 1 {
+		return nil
+	}
+	entries := make([]hamtEntry[K, V], 0, len(n.entries)-1)
+	entries = append(entries, n.entries[:pos]...)
+	entries = append(entries, n.entries[pos+1:]...)
+	return &hamtNode[K, V]{bitmap: n.bitmap &^ bit, entries: entries}
+}
+
+// All returns an iterator over the entries in an unspecified order
+func (m *PersistentMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.all(yield)
+	}
+}
+
+func (n *hamtNode[K, V]) all(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, e := range n.entries {
+		if e.sub != nil {
+			if !e.sub.all(yield) {
+				return false
+			}
+			continue
+		}
+		for _, kv := range e.kvs {
+			if !yield(kv.Key, kv.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
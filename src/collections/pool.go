@@ -0,0 +1,122 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// ErrPoolClosed is returned by Submit once the pool was closed or its results are no longer consumed
+var ErrPoolClosed = errors.New("pool is closed")
+
+// PanicError is reported for a task whose function panicked
+type PanicError struct {
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("task panicked: %v", e.Value)
+}
+
+// Pool processes submitted tasks on a fixed number of worker goroutines
+type Pool[T, R any] struct {
+	fn       func(T) (R, error)
+	tasks    chan T
+	results  chan poolResult[R]
+	wg       sync.WaitGroup
+	mu       sync.RWMutex
+	closed   bool
+	done     chan struct{} // closed when Results is stopped early
+	stopOnce sync.Once
+}
+
+type poolResult[R any] struct {
+	r   R
+	err error
+}
+
+// NewPool starts a pool with the given number of workers, each calling fn for the tasks it receives
+func NewPool[T, R any](workers int, fn func(T) (R, error)) *Pool[T, R] {
+	workers = max(workers, 1)
+	p := &Pool[T, R]{
+		fn:      fn,
+		tasks:   make(chan T),
+		results: make(chan poolResult[R], workers),
+		done:    make(chan struct{}),
+	}
+	for range workers {
+		p.wg.Add(1)
+		go p.work()
+	}
+	go func() {
+		p.wg.Wait()
+		close(p.results)
+	}()
+	return p
+}
+
+func (p *Pool[T, R]) work() {
+	defer p.wg.Done()
+	for t := range p.tasks {
+		select {
+		case p.results <- p.run(t):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *Pool[T, R]) run(t T) (res poolResult[R]) {
+	defer func() {
+		if v := recover(); v != nil {
+			res = poolResult[R]{err: &PanicError{v}}
+		}
+	}()
+	r, err := p.fn(t)
+	return poolResult[R]{r, err}
+}
+
+// Submit hands all tasks of seq to the workers and blocks until every task has been picked up.
+// Results must be consumed concurrently, otherwise Submit blocks once the result buffer is full.
+// It returns ErrPoolClosed if the pool is closed, or if the iteration over Results was stopped early,
+// in which case the remaining tasks are not submitted.
+func (p *Pool[T, R]) Submit(seq iter.Seq[T]) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrPoolClosed
+	}
+	for t := range seq {
+		select {
+		case p.tasks <- t:
+		case <-p.done:
+			return ErrPoolClosed
+		}
+	}
+	return nil
+}
+
+// Close signals that no more tasks will be submitted; Results ends once all tasks are done.
+// It waits for running Submit calls to return.
+func (p *Pool[T, R]) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.tasks)
+	}
+}
+
+// Results returns an iterator over task results in completion order. Stopping the iteration early
+// discards the outstanding results and lets the workers exit, so later Submit calls fail.
+func (p *Pool[T, R]) Results() iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		for res := range p.results {
+			if !yield(res.r, res.err) {
+				p.stopOnce.Do(func() { close(p.done) })
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+func square(v int) (int, error) {
+	return v * v, nil
+}
+
+func TestPool(t *testing.T) {
+	p := NewPool(3, square)
+	go func() {
+		if err := p.Submit(slices.Values([]int{1, 2, 3, 4})); err != nil {
+			t.Error(err)
+		}
+		p.Close()
+	}()
+	var got []int
+	for r, err := range p.Results() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, r)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 4, 9, 16}) {
+		t.Errorf("results %v", got)
+	}
+	if err := p.Submit(slices.Values([]int{5})); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Submit after Close = %v, want %v", err, ErrPoolClosed)
+	}
+}
+
+func TestPoolResultsStoppedEarly(t *testing.T) {
+	before := runtime.NumGoroutine()
+	p := NewPool(2, square)
+	submitted := make(chan error)
+	go func() {
+		submitted <- p.Submit(func(yield func(int) bool) {
+			for i := 0; yield(i); i++ {
+			}
+		})
+	}()
+	for range p.Results() {
+		break
+	}
+	if err := <-submitted; !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Submit after an early stop = %v, want %v", err, ErrPoolClosed)
+	}
+	p.Close()
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines leaked", runtime.NumGoroutine()-before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,167 @@
+package collections
+
+import "cmp"
+
+// PriorityItem is the handle of an element in a PriorityDeque
+type PriorityItem[T any, P cmp.Ordered] struct {
+	Value T
+	prio  P
+	seq   uint64
+	// index holds the positions in the min and the max heap, -1 once removed
+	index [2]int
+}
+
+func (it *PriorityItem[T, P]) Priority() P {
+	return it.prio
+}
+
+// PriorityDeque holds elements ordered by priority and can remove both the lowest and the highest one.
+// Elements of equal priority leave in insertion order from either end, and any element can be
+// removed or reprioritized through the handle returned by Push.
+type PriorityDeque[T any, P cmp.Ordered] struct {
+	heaps [2]pdHeap[T, P]
+	seq   uint64
+}
+
+// pdHeap is one of the two heaps; which selects the index slot of the items it maintains
+type pdHeap[T any, P cmp.Ordered] struct {
+	items []*PriorityItem[T, P]
+	which int
+}
+
+func NewPriorityDeque[T any, P cmp.Ordered]() *PriorityDeque[T, P] {
+	return &PriorityDeque[T, P]{heaps: [2]pdHeap[T, P]{{which: 0}, {which: 1}}}
+}
+
+// before reports whether a leaves before b: by priority in the direction of the heap, then first in first out
+func (h *pdHeap[T, P]) before(a, b *PriorityItem[T, P]) bool {
+	c := cmp.Compare(a.prio, b.prio)
+	if h.which == 1 {
+		c = -c
+	}
+	return c < 0 || (c == 0 && a.seq < b.seq)
+}
+
+func (h *pdHeap[T, P]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index[h.which] = i
+	h.items[j].index[h.which] = j
+}
+
+func (h *pdHeap[T, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.before(h.items[i], h.items[parent]) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *pdHeap[T, P]) down(i int) {
+	for {
+		best := i
+		for _, c := range [2]int{2*i + 1, 2*i + 2} {
+			if c < len(h.items) && h.before(h.items[c], h.items[best]) {
+				best = c
+			}
+		}
+		if best == i {
+			return
+		}
+		h.swap(i, best)
+		i = best
+	}
+}
+
+func (h *pdHeap[T, P]) push(it *PriorityItem[T, P]) {
+	h.items = append(h.items, it)
+	it.index[h.which] = len(h.items) - 1
+	h.up(len(h.items) - 1)
+}
+
+func (h *pdHeap[T, P]) remove(i int) {
+	last := len(h.items) - 1
+	h.items[i].index[h.which] = -1
+	if i != last {
+		h.items[i] = h.items[last]
+		h.items[i].index[h.which] = i
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i < last {
+		h.down(i)
+		h.up(i)
+	}
+}
+
+// Push adds value with prio and returns its handle
+func (d *PriorityDeque[T, P]) Push(value T, prio P) *PriorityItem[T, P] {
+	it := &PriorityItem[T, P]{Value: value, prio: prio, seq: d.seq}
+	d.seq++
+	d.heaps[0].push(it)
+	d.heaps[1].push(it)
+	return it
+}
+
+func (d *PriorityDeque[T, P]) peek(which int) *PriorityItem[T, P] {
+	if len(d.heaps[which].items) == 0 {
+		return nil
+	}
+	return d.heaps[which].items[0]
+}
+
+// PeekMin returns the element with the lowest priority without removing it
+func (d *PriorityDeque[T, P]) PeekMin() (*PriorityItem[T, P], bool) {
+	it := d.peek(0)
+	return it, it != nil
+}
+
+// PeekMax returns the element with the highest priority without removing it
+func (d *PriorityDeque[T, P]) PeekMax() (*PriorityItem[T, P], bool) {
+	it := d.peek(1)
+	return it, it != nil
+}
+
+// PopMin removes and returns the element with the lowest priority
+func (d *PriorityDeque[T, P]) PopMin() (*PriorityItem[T, P], bool) {
+	it := d.peek(0)
+	return it, d.Remove(it)
+}
+
+// PopMax removes and returns the element with the highest priority
+func (d *PriorityDeque[T, P]) PopMax() (*PriorityItem[T, P], bool) {
+	it := d.peek(1)
+	return it, d.Remove(it)
+}
+
+// Remove deletes the element of handle it and reports whether it was still queued
+func (d *PriorityDeque[T, P]) Remove(it *PriorityItem[T, P]) bool {
+	if it == nil || it.index[0] < 0 || it.index[0] >= len(d.heaps[0].items) || d.heaps[0].items[it.index[0]] != it {
+		return false
+	}
+	d.heaps[0].remove(it.index[0])
+	d.heaps[1].remove(it.index[1])
+	return true
+}
+
+// Update changes the priority of a queued element. It moves behind the elements that already have the new priority.
+func (d *PriorityDeque[T, P]) Update(it *PriorityItem[T, P], prio P) bool {
+	if !d.Remove(it) {
+		return false
+	}
+	it.prio, it.seq = prio, d.seq
+	d.seq++
+	d.heaps[0].push(it)
+	d.heaps[1].push(it)
+	return true
+}
+
+func (d *PriorityDeque[T, P]) Len() int {
+	return len(d.heaps[0].items)
+}
+
+func (d *PriorityDeque[T, P]) IsEmpty() bool {
+	return d.Len() == 0
+}
@@ -0,0 +1,103 @@
+package collections
+
+import "iter"
+
+// PriorityQueue is a binary heap popping the element with the highest priority first
+type PriorityQueue[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+// NewPriorityQueue creates a queue where less(a, b) means a has a higher priority than b
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+func (q *PriorityQueue[T]) Push(value T) {
+	q.data = append(q.data, value)
+	q.up(len(q.data) - 1)
+}
+
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	var zero T
+	if len(q.data) == 0 {
+		return zero, false
+	}
+	last := len(q.data) - 1
+	top := q.data[0]
+	q.data[0] = q.data[last]
+	q.data[last] = zero
+	q.data = q.data[:last]
+	q.down(0)
+	return top, true
+}
+
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.data[0], true
+}
+
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.data)
+}
+
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.data) == 0
+}
+
+// Drain returns an iterator that pops the elements in priority order, emptying the queue
+func (q *PriorityQueue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := q.Pop()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.data[i], q.data[parent]) {
+			return
+		}
+		q.data[i], q.data[parent] = q.data[parent], q.data[i]
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	for {
+		best := i
+		for _, c := range [2]int{2*i + 1, 2*i + 2} {
+			if c < len(q.data) && q.less(q.data[c], q.data[best]) {
+				best = c
+			}
+		}
+		if best == i {
+			return
+		}
+		q.data[i], q.data[best] = q.data[best], q.data[i]
+		i = best
+	}
+}
+
+// Validate checks that no element has a higher priority than its parent
+func (q *PriorityQueue[T]) Validate() error {
+	for i := 1; i < len(q.data); i++ {
+		if q.less(q.data[i], q.data[(i-1)/2]) {
+			return invariantf("element %d has a higher priority than its parent", i)
+		}
+	}
+	return nil
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (q *PriorityQueue[T]) CheckInvariants() {
+	checkInvariants(q)
+}
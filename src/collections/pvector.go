@@ -0,0 +1,205 @@
+package collections
+
+import "iter"
+
+const (
+	pvBits  = 5
+	pvWidth = 1 << pvBits
+	pvMask  = pvWidth - 1
+)
+
+// PersistentVector is an immutable sequence stored as a 32-way trie with a tail buffer.
+// Append, Set and Pop return new vectors that share all unchanged nodes with the original,
+// so keeping old versions around is cheap. The zero value is an empty vector.
+type PersistentVector[T any] struct {
+	size  int
+	shift uint
+	root  *pvNode[T]
+	tail  []T
+}
+
+type pvNode[T any] struct {
+	children [pvWidth]*pvNode[T]
+	values   []T
+}
+
+func NewPersistentVector[T any](values ...T) *PersistentVector[T] {
+	v := &PersistentVector[T]{}
+	for _, x := range values {
+		v = v.Append(x)
+	}
+	return v
+}
+
+func (v *PersistentVector[T]) Len() int {
+	return v.size
+}
+
+// tailOffset is the index of the first element in the tail
+func (v *PersistentVector[T]) tailOffset() int {
+	return v.size - len(v.tail)
+}
+
+func (v *PersistentVector[T]) shiftOrDefault() uint {
+	if v.root == nil {
+		return pvBits
+	}
+	return v.shift
+}
+
+// leaf returns the values of the trie leaf holding index i
+func (v *PersistentVector[T]) leaf(i int) []T {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+	n := v.root
+	for s := v.shift; s > 0; s -= pvBits {
+		n = n.children[(i>>s)&pvMask]
+	}
+	return n.values
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (v *PersistentVector[T]) Get(i int) T {
+	if i < 0 || i >= v.size {
+		panic("persistent vector: index out of range")
+	}
+	return v.leaf(i)[i&pvMask]
+}
+
+// Append returns a vector with value added at the end
+func (v *PersistentVector[T]) Append(value T) *PersistentVector[T] {
+	if len(v.tail) < pvWidth {
+		// The tail is copied because other versions may share its backing array
+		tail := make([]T, len(v.tail)+1, pvWidth)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = value
+		return &PersistentVector[T]{size: v.size + 1, shift: v.shift, root: v.root, tail: tail}
+	}
+
+	// Push the full tail into the trie
+	leaf := &pvNode[T]{values: v.tail}
+	shift := v.shiftOrDefault()
+	var root *pvNode[T]
+	if v.root == nil {
+		root = &pvNode[T]{}
+		root.children[0] = leaf
+	} else if v.tailOffset()>>pvBits >= 1<<shift {
+		// The trie is full, add a level
+		root = &pvNode[T]{}
+		root.children[0] = v.root
+		root.children[1] = newPVPath(shift, leaf)
+		shift += pvBits
+	} else {
+		root = pushPVLeaf(v.root, shift, v.tailOffset(), leaf)
+	}
+	return &PersistentVector[T]{size: v.size + 1, shift: shift, root: root, tail: []T{value}}
+}
+
+// newPVPath wraps leaf in nodes down to the given level
+func newPVPath[T any](shift uint, leaf *pvNode[T]) *pvNode[T] {
+	if shift == 0 {
+		return leaf
+	}
+	n := &pvNode[T]{}
+	n.children[0] = newPVPath(shift-pvBits, leaf)
+	return n
+}
+
+func pushPVLeaf[T any](n *pvNode[T], shift uint, i int, leaf *pvNode[T]) *pvNode[T] {
+	c := *n
+	idx := (i >> shift) & pvMask
+	if shift == pvBits {
+		c.children[idx] = leaf
+	} else if child := n.children[idx]; child != nil {
+		c.children[idx] = pushPVLeaf(child, shift-pvBits, i, leaf)
+	} else {
+		c.children[idx] = newPVPath(shift-pvBits, leaf)
+	}
+	return &c
+}
+
+// Set returns a vector with the element at index i replaced. It panics if i is out of range.
+func (v *PersistentVector[T]) Set(i int, value T) *PersistentVector[T] {
+	if i < 0 || i >= v.size {
+		panic("persistent vector: index out of range")
+	}
+	res := *v
+	if i >= v.tailOffset() {
+		res.tail = make([]T, len(v.tail), pvWidth)
+		copy(res.tail, v.tail)
+		res.tail[i&pvMask] = value
+		return &res
+	}
+	res.root = setPV(v.root, v.shift, i, value)
+	return &res
+}
+
+func setPV[T any](n *pvNode[T], shift uint, i int, value T) *pvNode[T] {
+	c := *n
+	if shift == 0 {
+		c.values = append([]T(nil), n.values...)
+		c.values[i&pvMask] = value
+		return &c
+	}
+	idx := (i >> shift) & pvMask
+	c.children[idx] = setPV(n.children[idx], shift-pvBits, i, value)
+	return &c
+}
+
+// Pop returns a vector without the last element. It panics if v is empty.
+func (v *PersistentVector[T]) Pop() *PersistentVector[T] {
+	switch {
+	case v.size == 0:
+		panic("persistent vector: pop from empty vector")
+	case v.size == 1:
+		return &PersistentVector[T]{}
+	case len(v.tail) > 1:
+		return &PersistentVector[T]{size: v.size - 1, shift: v.shift, root: v.root, tail: v.tail[:len(v.tail)-1]}
+	}
+
+	// The last leaf of the trie becomes the new tail
+	tail := v.leaf(v.size - 2)
+	root := popPV(v.root, v.shift, v.size-2)
+	shift := v.shift
+	if root == nil {
+		shift = 0
+	} else if shift > pvBits && root.children[1] == nil {
+		root = root.children[0]
+		shift -= pvBits
+	}
+	return &PersistentVector[T]{size: v.size - 1, shift: shift, root: root, tail: tail}
+}
+
+// popPV removes the leaf holding index i, which is the last leaf of the trie
+func popPV[T any](n *pvNode[T], shift uint, i int) *pvNode[T] {
+	idx := (i >> shift) & pvMask
+	if shift > pvBits {
+		child := popPV(n.children[idx], shift-pvBits, i)
+		if child == nil && idx == 0 {
+			return nil
+		}
+		c := *n
+		c.children[idx] = child
+		return &c
+	}
+	if idx == 0 {
+		return nil
+	}
+	c := *n
+	c.children[idx] = nil
+	return &c
+}
+
+// All returns an iterator over the indices and elements in order
+func (v *PersistentVector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < v.size; i += pvWidth {
+			for j, x := range v.leaf(i) {
+				if !yield(i+j, x) {
+					return
+				}
+			}
+		}
+	}
+}
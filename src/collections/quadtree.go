@@ -0,0 +1,171 @@
+package collections
+
+import "iter"
+
+// Point2D is a point in the plane
+type Point2D struct {
+	X, Y float64
+}
+
+func (r Rect) contains2D(p Point2D) bool {
+	return r.Min[0] <= p.X && p.X <= r.Max[0] && r.Min[1] <= p.Y && p.Y <= r.Max[1]
+}
+
+func (r Rect) intersects2D(o Rect) bool {
+	return r.Min[0] <= o.Max[0] && o.Min[0] <= r.Max[0] && r.Min[1] <= o.Max[1] && o.Min[1] <= r.Max[1]
+}
+
+// Quadtree indexes values by their position in a fixed two-dimensional area given as a Rect with two dimensions.
+// A node is split into four quadrants once it holds more than bucketSize entries, unless it is at maxDepth.
+type Quadtree[V any] struct {
+	root       *quadNode[V]
+	maxDepth   int
+	bucketSize int
+	size       int
+}
+
+type quadNode[V any] struct {
+	bounds   Rect
+	entries  []Pair[Point2D, V]
+	children *[4]quadNode[V]
+	// count is the number of entries in this subtree
+	count int
+}
+
+func NewQuadtree[V any](bounds Rect, maxDepth, bucketSize int) *Quadtree[V] {
+	return &Quadtree[V]{
+		root:       &quadNode[V]{bounds: bounds},
+		maxDepth:   max(maxDepth, 0),
+		bucketSize: max(bucketSize, 1),
+	}
+}
+
+func (n *quadNode[V]) quadrant(p Point2D) int {
+	midX := (n.bounds.Min[0] + n.bounds.Max[0]) / 2
+	midY := (n.bounds.Min[1] + n.bounds.Max[1]) / 2
+	q := 0
+	if p.X >= midX {
+		q |= 1
+	}
+	if p.Y >= midY {
+		q |= 2
+	}
+	return q
+}
+
+func (n *quadNode[V]) split() {
+	minX, minY := n.bounds.Min[0], n.bounds.Min[1]
+	maxX, maxY := n.bounds.Max[0], n.bounds.Max[1]
+	midX, midY := (minX+maxX)/2, (minY+maxY)/2
+	n.children = &[4]quadNode[V]{
+		{bounds: Rect{[]float64{minX, minY}, []float64{midX, midY}}},
+		{bounds: Rect{[]float64{midX, minY}, []float64{maxX, midY}}},
+		{bounds: Rect{[]float64{minX, midY}, []float64{midX, maxY}}},
+		{bounds: Rect{[]float64{midX, midY}, []float64{maxX, maxY}}},
+	}
+	for _, e := range n.entries {
+		c := &n.children[n.quadrant(e.Key)]
+		c.entries = append(c.entries, e)
+		c.count++
+	}
+	n.entries = nil
+}
+
+// Insert adds value at p and reports false if p lies outside the bounds of the tree
+func (t *Quadtree[V]) Insert(p Point2D, value V) bool {
+	if !t.root.bounds.contains2D(p) {
+		return false
+	}
+	n := t.root
+	for depth := 0; ; depth++ {
+		n.count++
+		if n.children == nil {
+			if len(n.entries) < t.bucketSize || depth >= t.maxDepth {
+				n.entries = append(n.entries, Pair[Point2D, V]{p, value})
+				t.size++
+				return true
+			}
+			n.split()
+		}
+		n = &n.children[n.quadrant(p)]
+	}
+}
+
+// Remove deletes all values at exactly p and returns how many were removed
+func (t *Quadtree[V]) Remove(p Point2D) int {
+	if !t.root.bounds.contains2D(p) {
+		return 0
+	}
+	removed := t.remove(t.root, p)
+	t.size -= removed
+	return removed
+}
+
+func (t *Quadtree[V]) remove(n *quadNode[V], p Point2D) int {
+	removed := 0
+	if n.children == nil {
+		kept := n.entries[:0]
+		for _, e := range n.entries {
+			if e.Key != p {
+				kept = append(kept, e)
+			}
+		}
+		clear(n.entries[len(kept):])
+		removed = len(n.entries) - len(kept)
+		n.entries = kept
+	} else {
+		removed = t.remove(&n.children[n.quadrant(p)], p)
+	}
+	n.count -= removed
+	// Collapse quadrants that became small enough to fit into one bucket
+	if n.children != nil && n.count <= t.bucketSize {
+		var entries []Pair[Point2D, V]
+		n.collect(&entries)
+		n.children, n.entries = nil, entries
+	}
+	return removed
+}
+
+func (n *quadNode[V]) collect(dst *[]Pair[Point2D, V]) {
+	*dst = append(*dst, n.entries...)
+	if n.children != nil {
+		for i := range n.children {
+			n.children[i].collect(dst)
+		}
+	}
+}
+
+func (t *Quadtree[V]) Len() int {
+	return t.size
+}
+
+// Query returns an iterator over all entries inside rect
+func (t *Quadtree[V]) Query(rect Rect) iter.Seq2[Point2D, V] {
+	return func(yield func(Point2D, V) bool) {
+		t.query(t.root, rect, yield)
+	}
+}
+
+func (t *Quadtree[V]) query(n *quadNode[V], rect Rect, yield func(Point2D, V) bool) bool {
+	if n.count == 0 || !n.bounds.intersects2D(rect) {
+		return true
+	}
+	for _, e := range n.entries {
+		if rect.contains2D(e.Key) && !yield(e.Key, e.Value) {
+			return false
+		}
+	}
+	if n.children != nil {
+		for i := range n.children {
+			if !t.query(&n.children[i], rect, yield) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// All returns an iterator over all entries
+func (t *Quadtree[V]) All() iter.Seq2[Point2D, V] {
+	return t.Query(t.root.bounds)
+}
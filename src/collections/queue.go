@@ -0,0 +1,122 @@
+package collections
+
+import "iter"
+
+// Queue is a FIFO queue backed by a growable circular buffer
+type Queue[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+func (q *Queue[T]) Enqueue(value T) {
+	if q.size == len(q.data) {
+		q.grow()
+	}
+	q.data[(q.head+q.size)%len(q.data)] = value
+	q.size++
+}
+
+func (q *Queue[T]) grow() {
+	data := make([]T, max(2*len(q.data), 8))
+	n := copy(data, q.data[q.head:])
+	copy(data[n:], q.data[:q.head])
+	q.data = data
+	q.head = 0
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.size == 0 {
+		return zero, false
+	}
+	val := q.data[q.head]
+	q.data[q.head] = zero
+	q.head = (q.head + 1) % len(q.data)
+	q.size--
+	return val, true
+}
+
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.data[q.head], true
+}
+
+func (q *Queue[T]) Len() int {
+	return q.size
+}
+
+func (q *Queue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// All() Function returns an iterator over all elements in the queue from front to back
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range q.size {
+			if !yield(q.data[(q.head+i)%len(q.data)]) {
+				return
+			}
+		}
+	}
+}
+
+// GobEncode encodes the elements from front to back
+func (q *Queue[T]) GobEncode() ([]byte, error) {
+	return gobEncodeSeq(q.All())
+}
+
+// GobDecode replaces the contents of q with data produced by GobEncode
+func (q *Queue[T]) GobDecode(data []byte) error {
+	s, err := gobDecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	q.data, q.head, q.size = s, 0, len(s)
+	return nil
+}
+
+// MarshalBinary encodes the elements from front to back with DefaultElementCodec
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return q.Marshal(DefaultElementCodec[T]())
+}
+
+// Marshal encodes the element count followed by every length-prefixed element encoded by codec
+func (q *Queue[T]) Marshal(codec Codec[T]) ([]byte, error) {
+	return appendElements(nil, q.Len(), q.All(), codec)
+}
+
+// UnmarshalBinary replaces the contents of q with data produced by MarshalBinary
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	return q.Unmarshal(data, DefaultElementCodec[T]())
+}
+
+// Unmarshal replaces the contents of q with data produced by Marshal using the same codec
+func (q *Queue[T]) Unmarshal(data []byte, codec Codec[T]) error {
+	values, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	q.data, q.head, q.size = values, 0, len(values)
+	return nil
+}
+
+// FormatWith renders the elements from front to back according to f
+func (q *Queue[T]) FormatWith(f TextFormat) string {
+	return formatElements(sprintSeq(q.All()), q.Len(), f)
+}
+
+func (q *Queue[T]) String() string {
+	return q.FormatWith(TextFormat{})
+}
+
+func (q *Queue[T]) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
@@ -0,0 +1,214 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+// RadixTree maps string keys to values like Trie, but merges chains of single-child nodes into one edge
+type RadixTree[V any] struct {
+	root radixNode[V]
+	size int
+}
+
+type radixNode[V any] struct {
+	// prefix is the label of the edge leading to this node
+	prefix string
+	// children is kept sorted by the first byte of their prefix
+	children []*radixNode[V]
+	value    V
+	terminal bool
+}
+
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+func (n *radixNode[V]) child(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.prefix[0]) - int(b)
+	})
+}
+
+func commonPrefixLen(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Insert stores value for key and reports whether key is new
+func (t *RadixTree[V]) Insert(key string, value V) bool {
+	n := &t.root
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok {
+			n.children = slices.Insert(n.children, i, &radixNode[V]{prefix: key})
+			n = n.children[i]
+			break
+		}
+		c := n.children[i]
+		common := commonPrefixLen(key, c.prefix)
+		if common < len(c.prefix) {
+			// Split the edge at the point where key diverges
+			split := &radixNode[V]{prefix: c.prefix[:common], children: []*radixNode[V]{c}}
+			c.prefix = c.prefix[common:]
+			n.children[i] = split
+			c = split
+		}
+		n, key = c, key[common:]
+	}
+	n.value = value
+	if n.terminal {
+		return false
+	}
+	n.terminal = true
+	t.size++
+	return true
+}
+
+func (t *RadixTree[V]) find(key string) *radixNode[V] {
+	n := &t.root
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok || !strings.HasPrefix(key, n.children[i].prefix) {
+			return nil
+		}
+		n = n.children[i]
+		key = key[len(n.prefix):]
+	}
+	return n
+}
+
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.terminal {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key, re-compressing the path, and reports whether it was present
+func (t *RadixTree[V]) Delete(key string) bool {
+	path := []*radixNode[V]{&t.root}
+	for rest := key; rest != ""; {
+		n := path[len(path)-1]
+		i, ok := n.child(rest[0])
+		if !ok || !strings.HasPrefix(rest, n.children[i].prefix) {
+			return false
+		}
+		rest = rest[len(n.children[i].prefix):]
+		path = append(path, n.children[i])
+	}
+	n := path[len(path)-1]
+	if !n.terminal {
+		return false
+	}
+	var zero V
+	n.value, n.terminal = zero, false
+	t.size--
+
+	if len(path) == 1 {
+		return true
+	}
+	parent := path[len(path)-2]
+	switch len(n.children) {
+	case 0:
+		i, _ := parent.child(n.prefix[0])
+		parent.children = slices.Delete(parent.children, i, i+1)
+		// The parent may now be a non-terminal node with a single child
+		if len(path) > 2 && !parent.terminal && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// mergeChild merges the only child of n into n
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.children = c.children
+	n.value, n.terminal = c.value, c.terminal
+}
+
+// LongestPrefixMatch returns the longest stored key that is a prefix of key
+func (t *RadixTree[V]) LongestPrefixMatch(key string) (string, V, bool) {
+	var (
+		best      *radixNode[V]
+		bestLen   int
+		consumed  int
+		n         = &t.root
+		remaining = key
+	)
+	if n.terminal {
+		best = n
+	}
+	for remaining != "" {
+		i, ok := n.child(remaining[0])
+		if !ok || !strings.HasPrefix(remaining, n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		consumed += len(n.prefix)
+		remaining = remaining[len(n.prefix):]
+		if n.terminal {
+			best, bestLen = n, consumed
+		}
+	}
+	if best == nil {
+		var zero V
+		return "", zero, false
+	}
+	return key[:bestLen], best.value, true
+}
+
+func (t *RadixTree[V]) Len() int {
+	return t.size
+}
+
+func (n *radixNode[V]) walk(key string, yield func(string, V) bool) bool {
+	if n.terminal && !yield(key, n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.walk(key+c.prefix, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// WalkPrefix returns an iterator over all keys starting with prefix in lexicographic order
+func (t *RadixTree[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		n, key, rest := &t.root, "", prefix
+		for rest != "" {
+			i, ok := n.child(rest[0])
+			if !ok {
+				return
+			}
+			c := n.children[i]
+			switch {
+			case strings.HasPrefix(rest, c.prefix):
+				rest = rest[len(c.prefix):]
+			case strings.HasPrefix(c.prefix, rest):
+				// prefix ends in the middle of this edge
+				rest = ""
+			default:
+				return
+			}
+			n, key = c, key+c.prefix
+		}
+		n.walk(key, yield)
+	}
+}
+
+// All() Function returns an iterator over all entries in lexicographic key order
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return t.WalkPrefix("")
+}
@@ -0,0 +1,34 @@
+package collections
+
+import "math/rand/v2"
+
+// The randomized structures hold an optional *rand.Rand set with SetRand. These helpers fall back to
+// the global source when it is nil.
+
+func randUint64(rng *rand.Rand) uint64 {
+	if rng == nil {
+		return rand.Uint64()
+	}
+	return rng.Uint64()
+}
+
+func randUint32(rng *rand.Rand) uint32 {
+	if rng == nil {
+		return rand.Uint32()
+	}
+	return rng.Uint32()
+}
+
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
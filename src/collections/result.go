@@ -0,0 +1,82 @@
+package collections
+
+import "fmt"
+
+// Result holds either a value or the error that prevented computing it
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultOf converts a (value, error) return into a Result, so that ResultOf(strconv.Atoi(s)) works directly
+func ResultOf[T any](v T, err error) Result[T] {
+	return Result[T]{value: v, err: err}
+}
+
+// Get returns the value and the error as a pair
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Err returns the error of a failed Result and nil otherwise
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value and panics if r holds an error
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("Unwrap called on a failed Result: %v", r.err))
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value, or def if r holds an error
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+// Option returns the value as an Option, discarding the error
+func (r Result[T]) Option() Option[T] {
+	return OptionOf(r.value, r.err == nil)
+}
+
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// MapResult applies f to the value of r, passing errors through unchanged
+func MapResult[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// AndThen applies the fallible f to the value of r, passing errors through unchanged
+func AndThen[T, U any](r Result[T], f func(T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return ResultOf(f(r.value))
+}
@@ -0,0 +1,93 @@
+package collections
+
+import "iter"
+
+// RingMode decides how a full RingBuffer handles a push
+type RingMode int
+
+const (
+	// OverwriteOldest replaces the oldest element
+	OverwriteOldest RingMode = iota
+	// RejectWhenFull refuses the new element
+	RejectWhenFull
+)
+
+// RingBuffer holds up to a fixed number of the most recently pushed elements
+type RingBuffer[T any] struct {
+	data []T
+	head int
+	size int
+	mode RingMode
+}
+
+func NewRingBuffer[T any](capacity int, mode RingMode) *RingBuffer[T] {
+	return &RingBuffer[T]{data: make([]T, max(capacity, 1)), mode: mode}
+}
+
+// Push adds value and reports whether it was stored
+func (r *RingBuffer[T]) Push(value T) bool {
+	if r.size == len(r.data) {
+		if r.mode == RejectWhenFull {
+			return false
+		}
+		r.data[r.head] = value
+		r.head = (r.head + 1) % len(r.data)
+		return true
+	}
+	r.data[(r.head+r.size)%len(r.data)] = value
+	r.size++
+	return true
+}
+
+// Pop removes and returns the oldest element
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	val := r.data[r.head]
+	r.data[r.head] = zero
+	r.head = (r.head + 1) % len(r.data)
+	r.size--
+	return val, true
+}
+
+// Peek returns the oldest element without removing it
+func (r *RingBuffer[T]) Peek() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.data[r.head], true
+}
+
+// At returns the i-th element counted from the oldest
+func (r *RingBuffer[T]) At(i int) T {
+	if i < 0 || i >= r.size {
+		panic("ringbuffer: index out of range")
+	}
+	return r.data[(r.head+i)%len(r.data)]
+}
+
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.data)
+}
+
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.size == len(r.data)
+}
+
+// All() Function returns an iterator over all elements in insertion order
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range r.size {
+			if !yield(r.data[(r.head+i)%len(r.data)]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package collections
+
+import (
+	"iter"
+	"strings"
+	"unicode/utf8"
+)
+
+const ropeLeafSize = 512
+
+// Rope is an immutable string stored as a balanced tree of chunks. Insert, Delete, Split and Concat
+// take O(log n) and return new ropes that share structure with the original. Positions are byte offsets.
+// The zero value is an empty rope.
+type Rope struct {
+	root *ropeNode
+}
+
+type ropeNode struct {
+	left, right *ropeNode
+	leaf        string
+	length      int
+	height      int
+}
+
+func NewRope(s string) Rope {
+	return Rope{buildRope(s)}
+}
+
+func buildRope(s string) *ropeNode {
+	if len(s) == 0 {
+		return nil
+	}
+	if len(s) <= ropeLeafSize {
+		return &ropeNode{leaf: s, length: len(s), height: 1}
+	}
+	mid := len(s) / 2
+	return newRopeNode(buildRope(s[:mid]), buildRope(s[mid:]))
+}
+
+func (n *ropeNode) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+func (n *ropeNode) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *ropeNode) isLeaf() bool {
+	return n.left == nil && n.right == nil
+}
+
+func newRopeNode(l, r *ropeNode) *ropeNode {
+	return &ropeNode{left: l, right: r, length: l.len() + r.len(), height: 1 + max(l.h(), r.h())}
+}
+
+// balanced creates a node from l and r whose heights differ by at most two, rotating if needed
+func balanced(l, r *ropeNode) *ropeNode {
+	switch {
+	case l.h() > r.h()+1:
+		if l.left.h() < l.right.h() {
+			return newRopeNode(newRopeNode(l.left, l.right.left), newRopeNode(l.right.right, r))
+		}
+		return newRopeNode(l.left, newRopeNode(l.right, r))
+	case r.h() > l.h()+1:
+		if r.right.h() < r.left.h() {
+			return newRopeNode(newRopeNode(l, r.left.left), newRopeNode(r.left.right, r.right))
+		}
+		return newRopeNode(newRopeNode(l, r.left), r.right)
+	}
+	return newRopeNode(l, r)
+}
+
+func joinRope(l, r *ropeNode) *ropeNode {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.isLeaf() && r.isLeaf() && l.length+r.length <= ropeLeafSize:
+		return &ropeNode{leaf: l.leaf + r.leaf, length: l.length + r.length, height: 1}
+	case l.h() > r.h()+1:
+		return balanced(l.left, joinRope(l.right, r))
+	case r.h() > l.h()+1:
+		return balanced(joinRope(l, r.left), r.right)
+	}
+	return newRopeNode(l, r)
+}
+
+func splitRope(n *ropeNode, i int) (*ropeNode, *ropeNode) {
+	switch {
+	case n == nil:
+		return nil, nil
+	case n.isLeaf():
+		return buildRope(n.leaf[:i]), buildRope(n.leaf[i:])
+	case i < n.left.len():
+		a, b := splitRope(n.left, i)
+		return a, joinRope(b, n.right)
+	default:
+		a, b := splitRope(n.right, i-n.left.len())
+		return joinRope(n.left, a), b
+	}
+}
+
+// Len returns the length in bytes
+func (r Rope) Len() int {
+	return r.root.len()
+}
+
+// Concat returns the rope of r followed by other
+func (r Rope) Concat(other Rope) Rope {
+	return Rope{joinRope(r.root, other.root)}
+}
+
+// Split returns the ropes before and after byte offset i
+func (r Rope) Split(i int) (Rope, Rope) {
+	a, b := splitRope(r.root, min(max(i, 0), r.Len()))
+	return Rope{a}, Rope{b}
+}
+
+// Insert returns a rope with s inserted at byte offset i
+func (r Rope) Insert(i int, s string) Rope {
+	a, b := r.Split(i)
+	return a.Concat(NewRope(s)).Concat(b)
+}
+
+// Delete returns a rope without the bytes in the range [i, j)
+func (r Rope) Delete(i, j int) Rope {
+	a, rest := r.Split(i)
+	_, b := rest.Split(j - max(i, 0))
+	return a.Concat(b)
+}
+
+// Index returns the byte at offset i
+func (r Rope) Index(i int) byte {
+	if i < 0 || i >= r.Len() {
+		panic("rope: index out of range")
+	}
+	n := r.root
+	for !n.isLeaf() {
+		if i < n.left.len() {
+			n = n.left
+		} else {
+			i -= n.left.len()
+			n = n.right
+		}
+	}
+	return n.leaf[i]
+}
+
+// Slice returns the bytes in the range [i, j) as a string
+func (r Rope) Slice(i, j int) string {
+	_, rest := r.Split(i)
+	mid, _ := rest.Split(j - max(i, 0))
+	return mid.String()
+}
+
+func (r Rope) String() string {
+	var sb strings.Builder
+	sb.Grow(r.Len())
+	for c := range r.Chunks() {
+		sb.WriteString(c)
+	}
+	return sb.String()
+}
+
+// Chunks returns an iterator over the stored pieces of the string in order
+func (r Rope) Chunks() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		r.root.chunks(yield)
+	}
+}
+
+func (n *ropeNode) chunks(yield func(string) bool) bool {
+	switch {
+	case n == nil:
+		return true
+	case n.isLeaf():
+		return yield(n.leaf)
+	}
+	return n.left.chunks(yield) && n.right.chunks(yield)
+}
+
+// Runes returns an iterator over the UTF-8 decoded runes, also across chunk boundaries
+func (r Rope) Runes() iter.Seq[rune] {
+	return func(yield func(rune) bool) {
+		var carry []byte
+		for c := range r.Chunks() {
+			if len(carry) > 0 {
+				// Complete a rune that was split between two chunks
+				for len(c) > 0 && !utf8.FullRune(carry) {
+					carry, c = append(carry, c[0]), c[1:]
+				}
+				if !utf8.FullRune(carry) {
+					continue
+				}
+				ch, size := utf8.DecodeRune(carry)
+				c = string(carry[size:]) + c
+				carry = carry[:0]
+				if !yield(ch) {
+					return
+				}
+			}
+			for len(c) > 0 {
+				if !utf8.FullRuneInString(c) {
+					carry = append(carry, c...)
+					break
+				}
+				ch, size := utf8.DecodeRuneInString(c)
+				c = c[size:]
+				if !yield(ch) {
+					return
+				}
+			}
+		}
+		for len(carry) > 0 {
+			ch, size := utf8.DecodeRune(carry)
+			carry = carry[size:]
+			if !yield(ch) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package collections
+
+import "fmt"
+
+// SegmentTree answers range queries over an associative combine function with point updates in O(log n)
+type SegmentTree[T any] struct {
+	n        int
+	tree     []T
+	identity T
+	combine  func(a, b T) T
+}
+
+// NewSegmentTree builds a tree over values in O(n). identity must satisfy combine(identity, x) == x.
+// combine does not need to be commutative.
+func NewSegmentTree[T any](values []T, identity T, combine func(a, b T) T) *SegmentTree[T] {
+	n := len(values)
+	t := &SegmentTree[T]{n: n, tree: make([]T, 2*n), identity: identity, combine: combine}
+	copy(t.tree[n:], values)
+	for i := n - 1; i > 0; i-- {
+		t.tree[i] = combine(t.tree[2*i], t.tree[2*i+1])
+	}
+	return t
+}
+
+func (t *SegmentTree[T]) Len() int {
+	return t.n
+}
+
+// Get returns the value at index i
+func (t *SegmentTree[T]) Get(i int) T {
+	t.check(i)
+	return t.tree[t.n+i]
+}
+
+// Set replaces the value at index i
+func (t *SegmentTree[T]) Set(i int, value T) {
+	t.check(i)
+	i += t.n
+	t.tree[i] = value
+	for i > 1 {
+		i /= 2
+		t.tree[i] = t.combine(t.tree[2*i], t.tree[2*i+1])
+	}
+}
+
+func (t *SegmentTree[T]) check(i int) {
+	if i < 0 || i >= t.n {
+		panic(fmt.Sprintf("segment tree: index %d out of range for length %d", i, t.n))
+	}
+}
+
+// Query combines the values in the index range [l, r)
+func (t *SegmentTree[T]) Query(l, r int) T {
+	l, r = max(l, 0), min(r, t.n)
+	left, right := t.identity, t.identity
+	for l, r = l+t.n, r+t.n; l < r; l, r = l/2, r/2 {
+		if l&1 == 1 {
+			left = t.combine(left, t.tree[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			right = t.combine(t.tree[r], right)
+		}
+	}
+	return t.combine(left, right)
+}
+
+// LazySegmentTree is a segment tree that also applies updates to whole index ranges in O(log n)
+// by deferring them until a query needs the affected nodes
+type LazySegmentTree[T, U any] struct {
+	n        int
+	tree     []T
+	lazy     []U
+	pending  []bool
+	identity T
+	combine  func(a, b T) T
+	apply    func(value T, update U, size int) T
+	compose  func(older, newer U) U
+}
+
+// NewLazySegmentTree builds a tree over values. apply returns the combined value of a range of size elements
+// after update was applied to each of them, compose merges two updates into one that has the effect of both.
+func NewLazySegmentTree[T, U any](values []T, identity T, combine func(a, b T) T,
+	apply func(value T, update U, size int) T, compose func(older, newer U) U) *LazySegmentTree[T, U] {
+	n := len(values)
+	t := &LazySegmentTree[T, U]{
+		n:        n,
+		tree:     make([]T, 4*max(n, 1)),
+		lazy:     make([]U, 4*max(n, 1)),
+		pending:  make([]bool, 4*max(n, 1)),
+		identity: identity,
+		combine:  combine,
+		apply:    apply,
+		compose:  compose,
+	}
+	if n > 0 {
+		t.build(1, 0, n, values)
+	}
+	return t
+}
+
+func (t *LazySegmentTree[T, U]) build(node, lo, hi int, values []T) {
+	if hi-lo == 1 {
+		t.tree[node] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	t.build(2*node, lo, mid, values)
+	t.build(2*node+1, mid, hi, values)
+	t.tree[node] = t.combine(t.tree[2*node], t.tree[2*node+1])
+}
+
+func (t *LazySegmentTree[T, U]) Len() int {
+	return t.n
+}
+
+func (t *LazySegmentTree[T, U]) applyTo(node, size int, update U) {
+	t.tree[node] = t.apply(t.tree[node], update, size)
+	if t.pending[node] {
+		t.lazy[node] = t.compose(t.lazy[node], update)
+	} else {
+		t.lazy[node], t.pending[node] = update, true
+	}
+}
+
+// push hands the deferred update of node down to its children
+func (t *LazySegmentTree[T, U]) push(node, lo, mid, hi int) {
+	if t.pending[node] {
+		t.applyTo(2*node, mid-lo, t.lazy[node])
+		t.applyTo(2*node+1, hi-mid, t.lazy[node])
+		var zero U
+		t.lazy[node], t.pending[node] = zero, false
+	}
+}
+
+// Update applies update to every element in the index range [l, r)
+func (t *LazySegmentTree[T, U]) Update(l, r int, update U) {
+	l, r = max(l, 0), min(r, t.n)
+	if l < r {
+		t.update(1, 0, t.n, l, r, update)
+	}
+}
+
+func (t *LazySegmentTree[T, U]) update(node, lo, hi, l, r int, update U) {
+	if l <= lo && hi <= r {
+		t.applyTo(node, hi-lo, update)
+		return
+	}
+	mid := (lo + hi) / 2
+	t.push(node, lo, mid, hi)
+	if l < mid {
+		t.update(2*node, lo, mid, l, r, update)
+	}
+	if r > mid {
+		t.update(2*node+1, mid, hi, l, r, update)
+	}
+	t.tree[node] = t.combine(t.tree[2*node], t.tree[2*node+1])
+}
+
+// Query combines the values in the index range [l, r)
+func (t *LazySegmentTree[T, U]) Query(l, r int) T {
+	l, r = max(l, 0), min(r, t.n)
+	if l >= r {
+		return t.identity
+	}
+	return t.query(1, 0, t.n, l, r)
+}
+
+func (t *LazySegmentTree[T, U]) query(node, lo, hi, l, r int) T {
+	if l <= lo && hi <= r {
+		return t.tree[node]
+	}
+	mid := (lo + hi) / 2
+	t.push(node, lo, mid, hi)
+	res := t.identity
+	if l < mid {
+		res = t.query(2*node, lo, mid, l, r)
+	}
+	if r > mid {
+		res = t.combine(res, t.query(2*node+1, mid, hi, l, r))
+	}
+	return res
+}
@@ -0,0 +1,28 @@
+package collections
+
+import "testing"
+
+func TestSegmentTreeIndexOutOfRange(t *testing.T) {
+	tree := NewSegmentTree([]int{1, 2, 3}, 0, func(a, b int) int { return a + b })
+	for _, i := range []int{-1, 3, -4} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Get(%d) did not panic", i)
+				}
+			}()
+			tree.Get(i)
+		}()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Set(%d) did not panic", i)
+				}
+			}()
+			tree.Set(i, 10)
+		}()
+	}
+	if got := tree.Query(0, 3); got != 6 {
+		t.Errorf("Query after rejected updates = %d, want 6", got)
+	}
+}
@@ -0,0 +1,177 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+)
+
+// Set is an unordered collection of unique elements. The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	data map[T]struct{}
+}
+
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{data: make(map[T]struct{}, len(values))}
+	for _, v := range values {
+		s.data[v] = struct{}{}
+	}
+	return s
+}
+
+// CollectSet builds a set from all elements of seq
+func CollectSet[T comparable](seq iter.Seq[T]) *Set[T] {
+	s := NewSet[T]()
+	for v := range seq {
+		s.data[v] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts value and reports whether it was not already present
+func (s *Set[T]) Add(value T) bool {
+	if _, ok := s.data[value]; ok {
+		return false
+	}
+	if s.data == nil {
+		s.data = make(map[T]struct{})
+	}
+	s.data[value] = struct{}{}
+	return true
+}
+
+// Remove deletes value and reports whether it was present
+func (s *Set[T]) Remove(value T) bool {
+	if _, ok := s.data[value]; !ok {
+		return false
+	}
+	delete(s.data, value)
+	return true
+}
+
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.data[value]
+	return ok
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.data)
+}
+
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+func (s *Set[T]) Clone() *Set[T] {
+	c := &Set[T]{data: make(map[T]struct{}, len(s.data))}
+	maps.Copy(c.data, s.data)
+	return c
+}
+
+// Union returns a new set with the elements of s and other
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	u := s.Clone()
+	maps.Copy(u.data, other.data)
+	return u
+}
+
+// Intersect returns a new set with the elements present in both s and other
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	r := NewSet[T]()
+	for v := range small.data {
+		if large.Contains(v) {
+			r.data[v] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Difference returns a new set with the elements of s that are not in other
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	r := NewSet[T]()
+	for v := range s.data {
+		if !other.Contains(v) {
+			r.data[v] = struct{}{}
+		}
+	}
+	return r
+}
+
+// SymmetricDifference returns a new set with the elements present in exactly one of s and other
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	r := s.Difference(other)
+	for v := range other.data {
+		if !s.Contains(v) {
+			r.data[v] = struct{}{}
+		}
+	}
+	return r
+}
+
+// IsSubset reports whether every element of s is also in other
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.data {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// All() Function returns an iterator over all elements in unspecified order
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.data)
+}
+
+// GobEncode encodes the elements in unspecified order
+func (s *Set[T]) GobEncode() ([]byte, error) {
+	return gobEncodeSeq(s.All())
+}
+
+// GobDecode replaces the contents of s with data produced by GobEncode
+func (s *Set[T]) GobDecode(data []byte) error {
+	values, err := gobDecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewSet(values...)
+	return nil
+}
+
+// Marshal encodes the elements in unspecified order with codec
+func (s *Set[T]) Marshal(codec Codec[T]) ([]byte, error) {
+	return appendElements(nil, s.Len(), s.All(), codec)
+}
+
+// Unmarshal replaces the contents of s with data produced by Marshal using the same codec
+func (s *Set[T]) Unmarshal(data []byte, codec Codec[T]) error {
+	values, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	*s = *NewSet(values...)
+	return nil
+}
+
+// FormatWith renders the elements sorted by their formatted text according to f
+func (s *Set[T]) FormatWith(f TextFormat) string {
+	return formatElements(sortedStrings(s.All()), s.Len(), f)
+}
+
+func (s *Set[T]) String() string {
+	return s.FormatWith(TextFormat{})
+}
+
+func (s *Set[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
@@ -0,0 +1,24 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetZeroValue(t *testing.T) {
+	var s Set[int]
+	if s.Contains(1) || s.Remove(1) || s.Len() != 0 {
+		t.Error("zero set is not empty")
+	}
+	if !s.Add(1) || s.Add(1) || !s.Contains(1) {
+		t.Error("Add on the zero set failed")
+	}
+	var empty Set[int]
+	u := empty.Union(NewSet(2, 3))
+	if got := slices.Sorted(u.All()); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("union with the zero set = %v", got)
+	}
+	if c := empty.Clone(); !c.Add(4) {
+		t.Error("clone of the zero set is not usable")
+	}
+}
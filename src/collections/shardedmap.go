@@ -0,0 +1,75 @@
+package collections
+
+import (
+	"hash/maphash"
+	"iter"
+)
+
+// ShardedMap partitions its keys across independently locked shards to reduce lock contention
+type ShardedMap[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []*SyncMap[K, V]
+}
+
+// NewShardedMap creates a map with the given number of shards
+func NewShardedMap[K comparable, V any](shards int) *ShardedMap[K, V] {
+	m := &ShardedMap[K, V]{
+		seed:   maphash.MakeSeed(),
+		shards: make([]*SyncMap[K, V], max(shards, 1)),
+	}
+	for i := range m.shards {
+		m.shards[i] = NewSyncMap[K, V]()
+	}
+	return m
+}
+
+func (m *ShardedMap[K, V]) shard(key K) *SyncMap[K, V] {
+	return m.shards[maphash.Comparable(m.seed, key)%uint64(len(m.shards))]
+}
+
+func (m *ShardedMap[K, V]) Load(key K) (V, bool) {
+	return m.shard(key).Load(key)
+}
+
+func (m *ShardedMap[K, V]) Store(key K, value V) {
+	m.shard(key).Store(key, value)
+}
+
+func (m *ShardedMap[K, V]) Delete(key K) {
+	m.shard(key).Delete(key)
+}
+
+func (m *ShardedMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	return m.shard(key).LoadOrStore(key, value)
+}
+
+// Len returns the total number of entries over all shards
+func (m *ShardedMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.shards {
+		n += s.Len()
+	}
+	return n
+}
+
+func (m *ShardedMap[K, V]) ShardCount() int {
+	return len(m.shards)
+}
+
+// Shard returns an iterator over a snapshot of the i-th shard
+func (m *ShardedMap[K, V]) Shard(i int) iter.Seq2[K, V] {
+	return m.shards[i].All()
+}
+
+// All() Function returns an iterator over all entries, snapshotting one shard at a time
+func (m *ShardedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, s := range m.shards {
+			for k, v := range s.All() {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
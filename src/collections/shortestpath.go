@@ -0,0 +1,143 @@
+package collections
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	// ErrNegativeWeight is returned by Dijkstra for graphs with negative edge weights
+	ErrNegativeWeight = errors.New("graph has a negative edge weight")
+	// ErrNegativeCycle is returned by BellmanFord if a negative cycle is reachable from the start node
+	ErrNegativeCycle = errors.New("graph has a negative cycle")
+)
+
+// ShortestPaths holds the shortest paths from one source node to all nodes reachable from it
+type ShortestPaths[N comparable] struct {
+	source N
+	dist   map[N]float64
+	prev   map[N]N
+}
+
+// Cost returns the length of the shortest path to node
+func (sp *ShortestPaths[N]) Cost(to N) (float64, bool) {
+	d, ok := sp.dist[to]
+	return d, ok
+}
+
+// Path returns the nodes of the shortest path from the source to node, including both ends, and its cost
+func (sp *ShortestPaths[N]) Path(to N) ([]N, float64, bool) {
+	d, ok := sp.dist[to]
+	if !ok {
+		return nil, 0, false
+	}
+	return buildPath(sp.source, to, sp.prev), d, true
+}
+
+func buildPath[N comparable](from, to N, prev map[N]N) []N {
+	path := []N{to}
+	for to != from {
+		to = prev[to]
+		path = append(path, to)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// Dijkstra computes the shortest paths from start using an indexed priority queue
+func (g *Graph[N]) Dijkstra(start N) (*ShortestPaths[N], error) {
+	for _, n := range g.nodes {
+		for _, e := range g.adj[n] {
+			if e.Weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+		}
+	}
+	sp := &ShortestPaths[N]{source: start, dist: make(map[N]float64), prev: make(map[N]N)}
+	if !g.HasNode(start) {
+		return sp, nil
+	}
+	done := make(map[N]bool)
+	q := NewIndexedPriorityQueue[N, float64]()
+	q.Push(start, 0)
+	sp.dist[start] = 0
+	for !q.IsEmpty() {
+		n, d, _ := q.Pop()
+		done[n] = true
+		for _, e := range g.adj[n] {
+			if done[e.To] {
+				continue
+			}
+			if old, ok := sp.dist[e.To]; !ok || d+e.Weight < old {
+				sp.dist[e.To] = d + e.Weight
+				sp.prev[e.To] = n
+				q.Push(e.To, d+e.Weight)
+			}
+		}
+	}
+	return sp, nil
+}
+
+// BellmanFord computes the shortest paths from start and supports negative edge weights
+func (g *Graph[N]) BellmanFord(start N) (*ShortestPaths[N], error) {
+	sp := &ShortestPaths[N]{source: start, dist: make(map[N]float64), prev: make(map[N]N)}
+	if !g.HasNode(start) {
+		return sp, nil
+	}
+	sp.dist[start] = 0
+	relax := func() bool {
+		changed := false
+		for _, n := range g.nodes {
+			d, ok := sp.dist[n]
+			if !ok {
+				continue
+			}
+			for _, e := range g.adj[n] {
+				if old, ok := sp.dist[e.To]; !ok || d+e.Weight < old {
+					sp.dist[e.To] = d + e.Weight
+					sp.prev[e.To] = n
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+	for range len(g.nodes) - 1 {
+		if !relax() {
+			return sp, nil
+		}
+	}
+	if relax() {
+		return nil, ErrNegativeCycle
+	}
+	return sp, nil
+}
+
+// AStar finds the shortest path from start to goal, guided by the heuristic h which estimates
+// the remaining cost to goal. h must never overestimate for the result to be optimal; a node that is
+// reached again on a cheaper path is reopened, so h need not be consistent. If goal cannot be reached,
+// AStar reports false with a zero cost like ShortestPaths.Path. Edge weights must not be negative.
+func (g *Graph[N]) AStar(start, goal N, h func(N) float64) ([]N, float64, bool) {
+	if !g.HasNode(start) || !g.HasNode(goal) {
+		return nil, 0, false
+	}
+	dist := map[N]float64{start: 0}
+	prev := make(map[N]N)
+	q := NewIndexedPriorityQueue[N, float64]()
+	q.Push(start, h(start))
+	for !q.IsEmpty() {
+		n, _, _ := q.Pop()
+		if n == goal {
+			return buildPath(start, goal, prev), dist[goal], true
+		}
+		for _, e := range g.adj[n] {
+			d := dist[n] + e.Weight
+			if old, ok := dist[e.To]; !ok || d < old {
+				dist[e.To] = d
+				prev[e.To] = n
+				q.Push(e.To, d+h(e.To))
+			}
+		}
+	}
+	return nil, 0, false
+}
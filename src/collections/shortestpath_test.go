@@ -0,0 +1,38 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	// h is admissible but not consistent, since h(a) exceeds the weight of a->b plus h(b)
+	g := NewGraph[string](true)
+	g.AddWeightedEdge("s", "a", 1)
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("b", "c", 1)
+	g.AddWeightedEdge("c", "t", 10)
+	g.AddWeightedEdge("s", "b", 4)
+	h := func(n string) float64 {
+		if n == "a" {
+			return 10
+		}
+		return 0
+	}
+	path, cost, ok := g.AStar("s", "t", h)
+	if !ok || cost != 13 || !slices.Equal(path, []string{"s", "a", "b", "c", "t"}) {
+		t.Errorf("AStar = %v, %v, %v, want the path through a with cost 13", path, cost, ok)
+	}
+}
+
+func TestAStarUnreachable(t *testing.T) {
+	g := NewGraph[int](true)
+	g.AddEdge(1, 2)
+	g.AddNode(3)
+	zero := func(int) float64 { return 0 }
+	for _, goal := range []int{3, 4} {
+		if path, cost, ok := g.AStar(1, goal, zero); ok || path != nil || cost != 0 {
+			t.Errorf("AStar(1, %d) = %v, %v, %v, want nil, 0, false", goal, path, cost, ok)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const skipListMaxLevel = 32
+
+// SkipList is a sorted map backed by a probabilistic skip list
+type SkipList[K cmp.Ordered, V any] struct {
+	head  skipNode[K, V]
+	level int
+	size  int
+	nodes *Arena[skipNode[K, V]]
+	links *Arena[*skipNode[K, V]]
+	// freeLinks holds the cleared link slices of deleted nodes by level-1, for reuse with an arena
+	freeLinks [skipListMaxLevel][][]*skipNode[K, V]
+	rng       *rand.Rand
+}
+
+type skipNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+}
+
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  skipNode[K, V]{next: make([]*skipNode[K, V], skipListMaxLevel)},
+		level: 1,
+	}
+}
+
+// NewSkipListWithArena creates a skip list that allocates its nodes and their links in blocks of blockSize
+func NewSkipListWithArena[K cmp.Ordered, V any](blockSize int) *SkipList[K, V] {
+	s := NewSkipList[K, V]()
+	s.nodes = NewArena[skipNode[K, V]](blockSize)
+	s.links = NewArena[*skipNode[K, V]](blockSize)
+	return s
+}
+
+// SetRand makes s draw node levels from rng, so that the list layout is reproducible.
+// A nil rng uses the global source.
+func (s *SkipList[K, V]) SetRand(rng *rand.Rand) {
+	s.rng = rng
+}
+
+// randomLevel returns a level where each additional level has a probability of 1/4
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && randUint32(s.rng)&3 == 0 {
+		level++
+	}
+	return level
+}
+
+// findPredecessors fills update with the rightmost node before key on every level and returns the node at key, if any
+func (s *SkipList[K, V]) findPredecessors(key K, update *[skipListMaxLevel]*skipNode[K, V]) *skipNode[K, V] {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].key < key {
+			n = n.next[i]
+		}
+		if update != nil {
+			update[i] = n
+		}
+	}
+	if next := n.next[0]; next != nil && next.key == key {
+		return next
+	}
+	return nil
+}
+
+// ceilingNode returns the first node with a key greater than or equal to key
+func (s *SkipList[K, V]) ceilingNode(key K) *skipNode[K, V] {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].key < key {
+			n = n.next[i]
+		}
+	}
+	return n.next[0]
+}
+
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.findPredecessors(key, nil); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (s *SkipList[K, V]) Has(key K) bool {
+	return s.findPredecessors(key, nil) != nil
+}
+
+func (s *SkipList[K, V]) Set(key K, value V) {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	if n := s.findPredecessors(key, &update); n != nil {
+		n.value = value
+		return
+	}
+	level := s.randomLevel()
+	for i := s.level; i < level; i++ {
+		update[i] = &s.head
+	}
+	s.level = max(s.level, level)
+	n := s.nodes.New()
+	n.key, n.value, n.next = key, value, s.allocLinks(level)
+	for i := range level {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	s.size++
+}
+
+// allocLinks returns a cleared link slice of the given level, reusing one of a deleted node if possible
+func (s *SkipList[K, V]) allocLinks(level int) []*skipNode[K, V] {
+	free := s.freeLinks[level-1]
+	if len(free) == 0 {
+		return s.links.Alloc(level)
+	}
+	links := free[len(free)-1]
+	free[len(free)-1] = nil
+	s.freeLinks[level-1] = free[:len(free)-1]
+	return links
+}
+
+// Delete removes key and reports whether it was present
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	n := s.findPredecessors(key, &update)
+	if n == nil {
+		return false
+	}
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	if s.nodes != nil {
+		// The link slice stays in its block, so clear it and keep it for the next node of its level
+		clear(n.next)
+		s.freeLinks[len(n.next)-1] = append(s.freeLinks[len(n.next)-1], n.next)
+		s.nodes.Free(n)
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+func (s *SkipList[K, V]) Len() int {
+	return s.size
+}
+
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// First returns the entry with the smallest key
+func (s *SkipList[K, V]) First() (K, V, bool) {
+	if n := s.head.next[0]; n != nil {
+		return n.key, n.value, true
+	}
+	return zeroEntry[K, V]()
+}
+
+// Last returns the entry with the largest key
+func (s *SkipList[K, V]) Last() (K, V, bool) {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil {
+			n = n.next[i]
+		}
+	}
+	if n == &s.head {
+		return zeroEntry[K, V]()
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].key <= key {
+			n = n.next[i]
+		}
+	}
+	if n == &s.head {
+		return zeroEntry[K, V]()
+	}
+	return n.key, n.value, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	if n := s.ceilingNode(key); n != nil {
+		return n.key, n.value, true
+	}
+	return zeroEntry[K, V]()
+}
+
+// All() Function returns an iterator over all entries in ascending key order
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// RangeFrom returns an iterator over all entries with keys greater than or equal to key in ascending order.
+// A scan can be resumed by calling RangeFrom again with the last key seen.
+func (s *SkipList[K, V]) RangeFrom(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.ceilingNode(key); n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi) in ascending order
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.ceilingNode(lo); n != nil && n.key < hi; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (s *SkipList[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
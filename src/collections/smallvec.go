@@ -0,0 +1,99 @@
+package collections
+
+import "iter"
+
+// smallVecInline is the number of elements a SmallVec stores without allocating.
+// Go generics cannot be parameterized by an array length, so the size is fixed.
+const smallVecInline = 8
+
+// SmallVec is a stack that keeps up to smallVecInline elements in an inline array and only moves them
+// to a heap-allocated slice once it grows beyond that. The zero value is an empty stack, and a SmallVec
+// declared as a local variable needs no allocation at all while it stays small. It has the same API as Stack.
+type SmallVec[T any] struct {
+	inline [smallVecInline]T
+	n      int
+	// heap holds all elements once the inline array overflowed
+	heap []T
+}
+
+func (s *SmallVec[T]) Push(value T) {
+	if s.heap != nil {
+		s.heap = append(s.heap, value)
+		return
+	}
+	if s.n < smallVecInline {
+		s.inline[s.n] = value
+		s.n++
+		return
+	}
+	s.heap = make([]T, s.n, 2*smallVecInline)
+	copy(s.heap, s.inline[:])
+	s.heap = append(s.heap, value)
+	s.inline = [smallVecInline]T{}
+	s.n = 0
+}
+
+func (s *SmallVec[T]) Pop() (T, bool) {
+	var zero T
+	if s.heap != nil {
+		if len(s.heap) == 0 {
+			return zero, false
+		}
+		last := len(s.heap) - 1
+		val := s.heap[last]
+		s.heap[last] = zero
+		s.heap = s.heap[:last]
+		return val, true
+	}
+	if s.n == 0 {
+		return zero, false
+	}
+	s.n--
+	val := s.inline[s.n]
+	s.inline[s.n] = zero
+	return val, true
+}
+
+func (s *SmallVec[T]) Peek() (T, bool) {
+	items := s.items()
+	if len(items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return items[len(items)-1], true
+}
+
+// items returns the elements as a slice, aliasing whichever storage is in use
+func (s *SmallVec[T]) items() []T {
+	if s.heap != nil {
+		return s.heap
+	}
+	return s.inline[:s.n]
+}
+
+func (s *SmallVec[T]) Len() int {
+	if s.heap != nil {
+		return len(s.heap)
+	}
+	return s.n
+}
+
+func (s *SmallVec[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Spilled reports whether the elements were moved to the heap
+func (s *SmallVec[T]) Spilled() bool {
+	return s.heap != nil
+}
+
+// All returns an iterator over all elements from bottom to top
+func (s *SmallVec[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.items() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SortedMap is the common interface of the sorted map implementations, so they can be swapped for one another
+type SortedMap[K cmp.Ordered, V any] interface {
+	Get(key K) (V, bool)
+	Has(key K) bool
+	Set(key K, value V)
+	Delete(key K) bool
+	Len() int
+	IsEmpty() bool
+	First() (K, V, bool)
+	Last() (K, V, bool)
+	Floor(key K) (K, V, bool)
+	Ceiling(key K) (K, V, bool)
+	All() iter.Seq2[K, V]
+	Range(lo, hi K) iter.Seq2[K, V]
+	Keys() iter.Seq[K]
+}
+
+var (
+	_ SortedMap[int, int] = (*TreeMap[int, int])(nil)
+	_ SortedMap[int, int] = (*AVLTree[int, int])(nil)
+	_ SortedMap[int, int] = (*SkipList[int, int])(nil)
+	_ SortedMap[int, int] = (*Treap[int, int])(nil)
+	_ SortedMap[int, int] = (*SplayTree[int, int])(nil)
+	_ SortedMap[int, int] = (*BTreeMap[int, int])(nil)
+)
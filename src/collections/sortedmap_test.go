@@ -0,0 +1,136 @@
+package collections
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+var sortedMaps = []struct {
+	name string
+	new  func() SortedMap[int, int]
+}{
+	{"TreeMap", func() SortedMap[int, int] { return NewTreeMap[int, int]() }},
+	{"AVLTree", func() SortedMap[int, int] { return NewAVLTree[int, int]() }},
+	{"SkipList", func() SortedMap[int, int] { return NewSkipList[int, int]() }},
+	{"Treap", func() SortedMap[int, int] { return NewTreap[int, int]() }},
+	{"SplayTree", func() SortedMap[int, int] { return NewSplayTree[int, int]() }},
+	{"BTreeMap", func() SortedMap[int, int] { return NewBTreeMap[int, int](2) }},
+	// Small arena blocks make the scenario span several blocks and reuse freed nodes
+	{"TreeMapWithArena", func() SortedMap[int, int] { return NewTreeMapWithArena[int, int](4) }},
+	{"AVLTreeWithArena", func() SortedMap[int, int] { return NewAVLTreeWithArena[int, int](4) }},
+	{"SkipListWithArena", func() SortedMap[int, int] { return NewSkipListWithArena[int, int](4) }},
+	{"TreapWithArena", func() SortedMap[int, int] { return NewTreapWithArena[int, int](4) }},
+	{"SplayTreeWithArena", func() SortedMap[int, int] { return NewSplayTreeWithArena[int, int](4) }},
+}
+
+// refFloor returns the largest key in the sorted slice keys that is <= key
+func refFloor(keys []int, key int) (int, bool) {
+	i, found := slices.BinarySearch(keys, key)
+	if found {
+		return keys[i], true
+	}
+	if i == 0 {
+		return 0, false
+	}
+	return keys[i-1], true
+}
+
+// refCeiling returns the smallest key in the sorted slice keys that is >= key
+func refCeiling(keys []int, key int) (int, bool) {
+	i, _ := slices.BinarySearch(keys, key)
+	if i == len(keys) {
+		return 0, false
+	}
+	return keys[i], true
+}
+
+// checkSortedMap compares every query of m with the reference map
+func checkSortedMap(t *testing.T, m SortedMap[int, int], ref map[int]int) {
+	t.Helper()
+	keys := slices.Sorted(maps.Keys(ref))
+	if m.Len() != len(ref) || m.IsEmpty() != (len(ref) == 0) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(ref))
+	}
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, keys) {
+		t.Fatalf("Keys() = %v, want %v", got, keys)
+	}
+	var i int
+	for k, v := range m.All() {
+		if i >= len(keys) || k != keys[i] || v != ref[k] {
+			t.Fatalf("All() yields %d: %d at position %d", k, v, i)
+		}
+		i++
+	}
+	if k, v, ok := m.First(); ok != (len(keys) > 0) || (ok && (k != keys[0] || v != ref[k])) {
+		t.Fatalf("First() = %d, %d, %v", k, v, ok)
+	}
+	if k, v, ok := m.Last(); ok != (len(keys) > 0) || (ok && (k != keys[len(keys)-1] || v != ref[k])) {
+		t.Fatalf("Last() = %d, %d, %v", k, v, ok)
+	}
+	for key := -2; key <= 66; key++ {
+		want, wantOk := ref[key]
+		if got, ok := m.Get(key); got != want || ok != wantOk || m.Has(key) != wantOk {
+			t.Fatalf("Get(%d) = %d, %v, want %d, %v", key, got, ok, want, wantOk)
+		}
+		k, v, ok := m.Floor(key)
+		if wk, wok := refFloor(keys, key); ok != wok || (ok && (k != wk || v != ref[wk])) {
+			t.Fatalf("Floor(%d) = %d, %v, want %d, %v", key, k, ok, wk, wok)
+		}
+		k, v, ok = m.Ceiling(key)
+		if wk, wok := refCeiling(keys, key); ok != wok || (ok && (k != wk || v != ref[wk])) {
+			t.Fatalf("Ceiling(%d) = %d, %v, want %d, %v", key, k, ok, wk, wok)
+		}
+	}
+	for _, r := range [][2]int{{-5, 100}, {10, 20}, {20, 10}, {7, 7}, {0, 1}, {63, 64}} {
+		var want []int
+		for _, k := range keys {
+			if k >= r[0] && k < r[1] {
+				want = append(want, k)
+			}
+		}
+		var got []int
+		for k, v := range m.Range(r[0], r[1]) {
+			if v != ref[k] {
+				t.Fatalf("Range(%d, %d) yields %d: %d, want %d", r[0], r[1], k, v, ref[k])
+			}
+			got = append(got, k)
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("Range(%d, %d) = %v, want %v", r[0], r[1], got, want)
+		}
+	}
+}
+
+func TestSortedMapConformance(t *testing.T) {
+	for _, impl := range sortedMaps {
+		t.Run(impl.name, func(t *testing.T) {
+			m, ref := impl.new(), make(map[int]int)
+			checkSortedMap(t, m, ref)
+			r := rand.New(rand.NewPCG(1, 2))
+			for step := range 2000 {
+				key := r.IntN(64)
+				switch r.IntN(3) {
+				case 0, 1:
+					m.Set(key, step)
+					ref[key] = step
+				case 2:
+					_, want := ref[key]
+					delete(ref, key)
+					if got := m.Delete(key); got != want {
+						t.Fatalf("Delete(%d) = %v, want %v", key, got, want)
+					}
+				}
+				if step%50 == 0 {
+					checkSortedMap(t, m, ref)
+				}
+			}
+			for key := range 64 {
+				m.Delete(key)
+				delete(ref, key)
+			}
+			checkSortedMap(t, m, ref)
+		})
+	}
+}
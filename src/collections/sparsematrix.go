@@ -0,0 +1,110 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+
+	"ROFT_examples/constraints"
+)
+
+// SparseMatrix stores only the non-zero elements of a matrix, keyed by row and column
+type SparseMatrix[T constraints.Number] struct {
+	rows, cols int
+	data       map[[2]int]T
+}
+
+func NewSparseMatrix[T constraints.Number](rows, cols int) *SparseMatrix[T] {
+	return &SparseMatrix[T]{rows: max(rows, 0), cols: max(cols, 0), data: make(map[[2]int]T)}
+}
+
+// SparseFromDense copies the non-zero elements of m
+func SparseFromDense[T constraints.Number](m *Matrix[T]) *SparseMatrix[T] {
+	s := NewSparseMatrix[T](m.rows, m.cols)
+	for i, v := range m.data {
+		if v != 0 {
+			s.data[[2]int{i / m.cols, i % m.cols}] = v
+		}
+	}
+	return s
+}
+
+func (s *SparseMatrix[T]) Rows() int {
+	return s.rows
+}
+
+func (s *SparseMatrix[T]) Cols() int {
+	return s.cols
+}
+
+func (s *SparseMatrix[T]) At(i, j int) T {
+	s.check(i, j)
+	return s.data[[2]int{i, j}]
+}
+
+// Set stores value at row i and column j. Storing zero removes the element.
+func (s *SparseMatrix[T]) Set(i, j int, value T) {
+	s.check(i, j)
+	if value == 0 {
+		delete(s.data, [2]int{i, j})
+	} else {
+		s.data[[2]int{i, j}] = value
+	}
+}
+
+func (s *SparseMatrix[T]) check(i, j int) {
+	if i < 0 || i >= s.rows || j < 0 || j >= s.cols {
+		panic("sparse matrix: index out of range")
+	}
+}
+
+// NNZ returns the number of non-zero elements
+func (s *SparseMatrix[T]) NNZ() int {
+	return len(s.data)
+}
+
+// NonZero returns an iterator over the positions and values of the non-zero elements in row-major order
+func (s *SparseMatrix[T]) NonZero() iter.Seq2[[2]int, T] {
+	return func(yield func([2]int, T) bool) {
+		keys := slices.SortedFunc(maps.Keys(s.data), func(a, b [2]int) int {
+			return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+		})
+		for _, k := range keys {
+			if !yield(k, s.data[k]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SparseMatrix[T]) ToDense() *Matrix[T] {
+	m := NewMatrix[T](s.rows, s.cols)
+	for k, v := range s.data {
+		m.data[k[0]*m.cols+k[1]] = v
+	}
+	return m
+}
+
+func (s *SparseMatrix[T]) Transpose() *SparseMatrix[T] {
+	r := NewSparseMatrix[T](s.cols, s.rows)
+	for k, v := range s.data {
+		r.data[[2]int{k[1], k[0]}] = v
+	}
+	return r
+}
+
+// MulDense returns the dense product s * m in time proportional to the non-zero elements of s times the columns of m
+func (s *SparseMatrix[T]) MulDense(m *Matrix[T]) (*Matrix[T], error) {
+	if s.cols != m.rows {
+		return nil, ErrDimensionMismatch
+	}
+	r := NewMatrix[T](s.rows, m.cols)
+	for k, a := range s.data {
+		out := r.data[k[0]*r.cols : (k[0]+1)*r.cols]
+		for j, b := range m.data[k[1]*m.cols : (k[1]+1)*m.cols] {
+			out[j] += a * b
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,83 @@
+package collections
+
+import "iter"
+
+// SparseSet stores non-negative integer IDs with O(1) add, remove and contains.
+// Members are kept packed in a dense slice, so iteration only touches present elements.
+type SparseSet struct {
+	dense  []int
+	sparse []int
+}
+
+// NewSparseSet creates a set with room for IDs below capacity before it has to grow
+func NewSparseSet(capacity int) *SparseSet {
+	return &SparseSet{sparse: make([]int, max(capacity, 0))}
+}
+
+func (s *SparseSet) Contains(id int) bool {
+	if id < 0 || id >= len(s.sparse) {
+		return false
+	}
+	i := s.sparse[id]
+	return i < len(s.dense) && s.dense[i] == id
+}
+
+// Add inserts id and reports whether it was not yet present. It panics if id is negative.
+func (s *SparseSet) Add(id int) bool {
+	if id < 0 {
+		panic("sparseset: negative id")
+	}
+	if s.Contains(id) {
+		return false
+	}
+	if id >= len(s.sparse) {
+		s.sparse = append(s.sparse, make([]int, max(id+1, 2*len(s.sparse))-len(s.sparse))...)
+	}
+	s.sparse[id] = len(s.dense)
+	s.dense = append(s.dense, id)
+	return true
+}
+
+// Remove deletes id by moving the last member into its slot, so the order of members changes
+func (s *SparseSet) Remove(id int) bool {
+	if !s.Contains(id) {
+		return false
+	}
+	i, last := s.sparse[id], s.dense[len(s.dense)-1]
+	s.dense[i] = last
+	s.sparse[last] = i
+	s.dense = s.dense[:len(s.dense)-1]
+	return true
+}
+
+func (s *SparseSet) Len() int {
+	return len(s.dense)
+}
+
+// Clear removes all members in O(1)
+func (s *SparseSet) Clear() {
+	s.dense = s.dense[:0]
+}
+
+// Dense returns the members in storage order. The slice is only valid until the next modification.
+func (s *SparseSet) Dense() []int {
+	return s.dense
+}
+
+// IndexOf returns the position of id in the dense slice, which can index parallel component arrays
+func (s *SparseSet) IndexOf(id int) (int, bool) {
+	if !s.Contains(id) {
+		return 0, false
+	}
+	return s.sparse[id], true
+}
+
+func (s *SparseSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, id := range s.dense {
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
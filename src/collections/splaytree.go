@@ -0,0 +1,302 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SplayTree is a self-adjusting sorted map. Every access moves the key to the root,
+// so a small set of frequently used keys is found quickly. Lookups modify the tree.
+type SplayTree[K cmp.Ordered, V any] struct {
+	root  *splayNode[K, V]
+	size  int
+	nodes *Arena[splayNode[K, V]]
+}
+
+type splayNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *splayNode[K, V]
+}
+
+func NewSplayTree[K cmp.Ordered, V any]() *SplayTree[K, V] {
+	return &SplayTree[K, V]{}
+}
+
+// NewSplayTreeWithArena creates a splay tree that allocates its nodes in blocks of blockSize
+func NewSplayTreeWithArena[K cmp.Ordered, V any](blockSize int) *SplayTree[K, V] {
+	return &SplayTree[K, V]{nodes: NewArena[splayNode[K, V]](blockSize)}
+}
+
+// splay moves the node with key, or the last node on its search path, to the root (top-down splaying)
+func splay[K cmp.Ordered, V any](t *splayNode[K, V], key K) *splayNode[K, V] {
+	if t == nil {
+		return nil
+	}
+	var header splayNode[K, V]
+	l, r := &header, &header
+	for {
+		if key < t.key {
+			if t.left == nil {
+				break
+			}
+			if key < t.left.key {
+				y := t.left
+				t.left = y.right
+				y.right = t
+				t = y
+				if t.left == nil {
+					break
+				}
+			}
+			r.left = t
+			r = t
+			t = t.left
+		} else if key > t.key {
+			if t.right == nil {
+				break
+			}
+			if key > t.right.key {
+				y := t.right
+				t.right = y.left
+				y.left = t
+				t = y
+				if t.right == nil {
+					break
+				}
+			}
+			l.right = t
+			l = t
+			t = t.right
+		} else {
+			break
+		}
+	}
+	l.right = t.left
+	r.left = t.right
+	t.left = header.right
+	t.right = header.left
+	return t
+}
+
+func (t *SplayTree[K, V]) Get(key K) (V, bool) {
+	t.root = splay(t.root, key)
+	if t.root != nil && t.root.key == key {
+		return t.root.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *SplayTree[K, V]) Has(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+func (t *SplayTree[K, V]) Set(key K, value V) {
+	t.root = splay(t.root, key)
+	if t.root != nil && t.root.key == key {
+		t.root.value = value
+		return
+	}
+	n := t.nodes.New()
+	n.key, n.value = key, value
+	if t.root != nil {
+		if key < t.root.key {
+			n.left, n.right = t.root.left, t.root
+			t.root.left = nil
+		} else {
+			n.left, n.right = t.root, t.root.right
+			t.root.right = nil
+		}
+	}
+	t.root = n
+	t.size++
+}
+
+// Delete removes key and reports whether it was present
+func (t *SplayTree[K, V]) Delete(key K) bool {
+	t.root = splay(t.root, key)
+	if t.root == nil || t.root.key != key {
+		return false
+	}
+	old := t.root
+	if old.left == nil {
+		t.root = old.right
+	} else {
+		// key is larger than everything on the left, so the maximum becomes the root
+		t.root = splay(old.left, key)
+		t.root.right = old.right
+	}
+	t.nodes.Free(old)
+	t.size--
+	return true
+}
+
+func (t *SplayTree[K, V]) Len() int {
+	return t.size
+}
+
+func (t *SplayTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// First returns the entry with the smallest key
+func (t *SplayTree[K, V]) First() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+// Last returns the entry with the largest key
+func (t *SplayTree[K, V]) Last() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (t *SplayTree[K, V]) Floor(key K) (K, V, bool) {
+	var best *splayNode[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key
+func (t *SplayTree[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *splayNode[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			best = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// walk yields the entries of the subtree of n with keys in [lo, hi) and reports whether to continue
+func (n *splayNode[K, V]) walk(lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if lo == nil || n.key >= *lo {
+		if !n.left.walk(lo, hi, yield) {
+			return false
+		}
+		if hi != nil && n.key >= *hi {
+			return false
+		}
+		if !yield(n.key, n.value) {
+			return false
+		}
+	}
+	return n.right.walk(lo, hi, yield)
+}
+
+// All() Function returns an iterator over all entries in ascending key order
+func (t *SplayTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.walk(nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi) in ascending order
+func (t *SplayTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.walk(&lo, &hi, yield)
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (t *SplayTree[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range t.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Marshal encodes the entries in ascending key order with codec
+func (t *SplayTree[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return marshalEntries(t.Len(), t.All(), codec)
+}
+
+// Unmarshal replaces the contents of t with data produced by Marshal using the same codec
+func (t *SplayTree[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	t.root, t.size = nil, 0
+	t.nodes.Reset()
+	for _, e := range entries {
+		t.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// Validate checks the search order and the cached size. Splay trees have no balance invariant.
+func (t *SplayTree[K, V]) Validate() error {
+	n, err := t.root.validate(nil, nil)
+	if err == nil && n != t.size {
+		err = invariantf("size %d, but %d nodes", t.size, n)
+	}
+	return err
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (t *SplayTree[K, V]) CheckInvariants() {
+	checkInvariants(t)
+}
+
+// validate returns the number of nodes in the subtree rooted at n
+func (n *splayNode[K, V]) validate(lo, hi *K) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+		return 0, invariantf("key %v out of order", n.key)
+	}
+	lc, err := n.left.validate(lo, &n.key)
+	if err != nil {
+		return 0, err
+	}
+	rc, err := n.right.validate(&n.key, hi)
+	if err != nil {
+		return 0, err
+	}
+	return lc + rc + 1, nil
+}
@@ -0,0 +1,57 @@
+package collections
+
+import "sync/atomic"
+
+// SPSCRing is a fixed-capacity lock-free ring buffer for exactly one producer and one consumer goroutine
+type SPSCRing[T any] struct {
+	data []T
+	mask uint64
+	_    [64]byte
+	// head is only written by the consumer
+	head atomic.Uint64
+	_    [56]byte
+	// tail is only written by the producer
+	tail atomic.Uint64
+	_    [56]byte
+}
+
+// NewSPSCRing creates a ring buffer whose capacity is capacity rounded up to a power of two
+func NewSPSCRing[T any](capacity int) *SPSCRing[T] {
+	n := 1
+	for n < capacity {
+		n <<= 1
+	}
+	return &SPSCRing[T]{data: make([]T, n), mask: uint64(n - 1)}
+}
+
+// Push appends value and reports false if the buffer is full. Must only be called by the producer.
+func (r *SPSCRing[T]) Push(value T) bool {
+	tail := r.tail.Load()
+	if tail-r.head.Load() == uint64(len(r.data)) {
+		return false
+	}
+	r.data[tail&r.mask] = value
+	r.tail.Store(tail + 1)
+	return true
+}
+
+// Pop removes the oldest value and reports false if the buffer is empty. Must only be called by the consumer.
+func (r *SPSCRing[T]) Pop() (T, bool) {
+	var zero T
+	head := r.head.Load()
+	if head == r.tail.Load() {
+		return zero, false
+	}
+	val := r.data[head&r.mask]
+	r.data[head&r.mask] = zero
+	r.head.Store(head + 1)
+	return val, true
+}
+
+func (r *SPSCRing[T]) Len() int {
+	return int(r.tail.Load() - r.head.Load())
+}
+
+func (r *SPSCRing[T]) Cap() int {
+	return len(r.data)
+}
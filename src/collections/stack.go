@@ -0,0 +1,136 @@
+package collections
+
+import "iter"
+
+type Stack[T any] struct {
+	data   []T
+	growth GrowthPolicy
+}
+
+// GrowthPolicy returns the new capacity of a full backing slice holding n elements. The result must be larger than n.
+type GrowthPolicy func(n int) int
+
+// GrowExact grows the backing slice by a single element, so no capacity is ever wasted
+func GrowExact(n int) int {
+	return n + 1
+}
+
+// GrowDoubling doubles the capacity, starting at 8 elements
+func GrowDoubling(n int) int {
+	return max(2*n, 8)
+}
+
+// GrowChunked grows the capacity by size elements at a time
+func GrowChunked(size int) GrowthPolicy {
+	size = max(size, 1)
+	return func(n int) int { return n + size }
+}
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// NewStackWithGrowth creates a stack whose backing slice grows according to policy instead of append's
+// default strategy. A nil policy behaves like NewStack.
+func NewStackWithGrowth[T any](policy GrowthPolicy) *Stack[T] {
+	return &Stack[T]{growth: policy}
+}
+
+func (s *Stack[T]) Push(value T) {
+	if s.growth != nil && len(s.data) == cap(s.data) {
+		data := make([]T, len(s.data), max(s.growth(len(s.data)), len(s.data)+1))
+		copy(data, s.data)
+		s.data = data
+	}
+	s.data = append(s.data, value)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	index := len(s.data) - 1
+	val := s.data[index]
+	// Clear the vacated slot so the popped value can be garbage collected
+	var zero T
+	s.data[index] = zero
+	s.data = s.data[:index]
+	return val, true
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.data[len(s.data)-1], true
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.data)
+}
+
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Validate always succeeds since a slice-backed stack has no invariants of its own.
+// It lets stacks be driven and checked like the other containers.
+func (s *Stack[T]) Validate() error {
+	return nil
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (s *Stack[T]) CheckInvariants() {
+	checkInvariants(s)
+}
+
+// All() Function returns an iterator over all elements in the stack
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.data {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary encodes the elements from bottom to top with DefaultElementCodec
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return s.Marshal(DefaultElementCodec[T]())
+}
+
+// Marshal encodes the element count followed by every length-prefixed element encoded by codec
+func (s *Stack[T]) Marshal(codec Codec[T]) ([]byte, error) {
+	return appendElements(nil, s.Len(), s.All(), codec)
+}
+
+// UnmarshalBinary replaces the contents of s with data produced by MarshalBinary
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	return s.Unmarshal(data, DefaultElementCodec[T]())
+}
+
+// Unmarshal replaces the contents of s with data produced by Marshal using the same codec
+func (s *Stack[T]) Unmarshal(data []byte, codec Codec[T]) error {
+	values, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	s.data = values
+	return nil
+}
+
+// FormatWith renders the elements from bottom to top according to f
+func (s *Stack[T]) FormatWith(f TextFormat) string {
+	return formatElements(sprintSeq(s.All()), s.Len(), f)
+}
+
+func (s *Stack[T]) String() string {
+	return s.FormatWith(TextFormat{})
+}
+
+func (s *Stack[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
@@ -0,0 +1,49 @@
+package collections
+
+import "testing"
+
+const stackBenchSize = 10_000
+
+func benchmarkStackPush(b *testing.B, policy GrowthPolicy) {
+	b.ReportAllocs()
+	for range b.N {
+		s := NewStackWithGrowth[int](policy)
+		for i := range stackBenchSize {
+			s.Push(i)
+		}
+	}
+}
+
+func BenchmarkStackPushAppend(b *testing.B)   { benchmarkStackPush(b, nil) }
+func BenchmarkStackPushExact(b *testing.B)    { benchmarkStackPush(b, GrowExact) }
+func BenchmarkStackPushDoubling(b *testing.B) { benchmarkStackPush(b, GrowDoubling) }
+func BenchmarkStackPushChunked(b *testing.B)  { benchmarkStackPush(b, GrowChunked(256)) }
+
+func benchmarkStackPop(b *testing.B, policy GrowthPolicy) {
+	b.ReportAllocs()
+	s := NewStackWithGrowth[int](policy)
+	for range b.N {
+		b.StopTimer()
+		for i := range stackBenchSize {
+			s.Push(i)
+		}
+		b.StartTimer()
+		for !s.IsEmpty() {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkStackPopAppend(b *testing.B)   { benchmarkStackPop(b, nil) }
+func BenchmarkStackPopExact(b *testing.B)    { benchmarkStackPop(b, GrowExact) }
+func BenchmarkStackPopDoubling(b *testing.B) { benchmarkStackPop(b, GrowDoubling) }
+func BenchmarkStackPopChunked(b *testing.B)  { benchmarkStackPop(b, GrowChunked(256)) }
+
+func TestStackPopClearsSlot(t *testing.T) {
+	s := NewStack[*int]()
+	s.Push(new(int))
+	s.Pop()
+	if s.data[:1][0] != nil {
+		t.Fatal("popped value is still referenced by the backing slice")
+	}
+}
@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// StripedStack spreads its elements over several independently locked stacks.
+// Elements are only in LIFO order per stripe, in exchange for less lock contention.
+type StripedStack[T any] struct {
+	stripes []stripe[T]
+	next    atomic.Uint64
+}
+
+type stripe[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+	// Keep stripes on separate cache lines
+	_ [64]byte
+}
+
+func NewStripedStack[T any](stripes int) *StripedStack[T] {
+	return &StripedStack[T]{stripes: make([]stripe[T], max(stripes, 1))}
+}
+
+func (s *StripedStack[T]) Push(value T) {
+	st := &s.stripes[s.next.Add(1)%uint64(len(s.stripes))]
+	st.mu.Lock()
+	st.stack.Push(value)
+	st.mu.Unlock()
+}
+
+// Pop removes an element from the first non-empty stripe, starting at a rotating position
+func (s *StripedStack[T]) Pop() (T, bool) {
+	start := s.next.Load()
+	for i := range uint64(len(s.stripes)) {
+		st := &s.stripes[(start+i)%uint64(len(s.stripes))]
+		st.mu.Lock()
+		v, ok := st.stack.Pop()
+		st.mu.Unlock()
+		if ok {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func (s *StripedStack[T]) Len() int {
+	n := 0
+	for i := range s.stripes {
+		st := &s.stripes[i]
+		st.mu.Lock()
+		n += st.stack.Len()
+		st.mu.Unlock()
+	}
+	return n
+}
+
+func (s *StripedStack[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
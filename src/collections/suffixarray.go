@@ -0,0 +1,154 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Symbol is the element type of texts indexed by a SuffixArray
+type Symbol interface {
+	~byte | ~rune
+}
+
+// SuffixArray indexes all suffixes of a text in sorted order for fast substring search
+type SuffixArray[T Symbol] struct {
+	text []T
+	sa   []int
+	lcp  []int
+}
+
+// NewSuffixArray builds the suffix array of text by prefix doubling in O(n log² n)
+func NewSuffixArray[T Symbol](text []T) *SuffixArray[T] {
+	n := len(text)
+	sa := make([]int, n)
+	rank := make([]int, n)
+	tmp := make([]int, n)
+	// Ranks start at 1, leaving 0 to mark positions past the end of the text
+	for i := range n {
+		sa[i] = i
+		rank[i] = int(text[i]) + 1
+	}
+
+	for k := 1; n > 1; k *= 2 {
+		// Rank of the suffix k positions further, 0 if it is past the end
+		second := func(i int) int {
+			if i+k < n {
+				return rank[i+k]
+			}
+			return 0
+		}
+		compare := func(a, b int) int {
+			if c := cmp.Compare(rank[a], rank[b]); c != 0 {
+				return c
+			}
+			return cmp.Compare(second(a), second(b))
+		}
+		slices.SortFunc(sa, compare)
+		tmp[sa[0]] = 1
+		for i := 1; i < n; i++ {
+			tmp[sa[i]] = tmp[sa[i-1]]
+			if compare(sa[i-1], sa[i]) < 0 {
+				tmp[sa[i]]++
+			}
+		}
+		copy(rank, tmp)
+		if rank[sa[n-1]] == n {
+			break
+		}
+	}
+	return &SuffixArray[T]{text: text, sa: sa}
+}
+
+func (s *SuffixArray[T]) Len() int {
+	return len(s.sa)
+}
+
+// Suffix returns the starting offset of the i-th smallest suffix
+func (s *SuffixArray[T]) Suffix(i int) int {
+	return s.sa[i]
+}
+
+// comparePrefix compares the suffix at offset with pattern, treating the suffix as equal if pattern is a prefix of it
+func (s *SuffixArray[T]) comparePrefix(offset int, pattern []T) int {
+	suffix := s.text[offset:]
+	if len(suffix) > len(pattern) {
+		suffix = suffix[:len(pattern)]
+	}
+	return slices.Compare(suffix, pattern)
+}
+
+func (s *SuffixArray[T]) bounds(pattern []T) (int, int) {
+	lo, _ := slices.BinarySearchFunc(s.sa, pattern, func(offset int, p []T) int {
+		if s.comparePrefix(offset, p) < 0 {
+			return -1
+		}
+		return 1
+	})
+	hi, _ := slices.BinarySearchFunc(s.sa[lo:], pattern, func(offset int, p []T) int {
+		if s.comparePrefix(offset, p) <= 0 {
+			return -1
+		}
+		return 1
+	})
+	return lo, lo + hi
+}
+
+// Lookup returns an iterator over the offsets of all occurrences of pattern, in suffix order
+func (s *SuffixArray[T]) Lookup(pattern []T) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		lo, hi := s.bounds(pattern)
+		for _, offset := range s.sa[lo:hi] {
+			if !yield(offset) {
+				return
+			}
+		}
+	}
+}
+
+// Count returns the number of occurrences of pattern
+func (s *SuffixArray[T]) Count(pattern []T) int {
+	lo, hi := s.bounds(pattern)
+	return hi - lo
+}
+
+// LCP returns the longest-common-prefix array, where LCP()[i] is the length of the common prefix
+// of the suffixes i-1 and i in sorted order. It is computed on first use with Kasai's algorithm.
+func (s *SuffixArray[T]) LCP() []int {
+	if s.lcp != nil || len(s.sa) == 0 {
+		return s.lcp
+	}
+	n := len(s.sa)
+	rank := make([]int, n)
+	for i, offset := range s.sa {
+		rank[offset] = i
+	}
+	s.lcp = make([]int, n)
+	h := 0
+	for i := range n {
+		if rank[i] == 0 {
+			h = 0
+			continue
+		}
+		j := s.sa[rank[i]-1]
+		for i+h < n && j+h < n && s.text[i+h] == s.text[j+h] {
+			h++
+		}
+		s.lcp[rank[i]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return s.lcp
+}
+
+// LongestRepeated returns the offset and length of a longest substring occurring at least twice
+func (s *SuffixArray[T]) LongestRepeated() (int, int) {
+	best, offset := 0, 0
+	for i, l := range s.LCP() {
+		if l > best {
+			best, offset = l, s.sa[i]
+		}
+	}
+	return offset, best
+}
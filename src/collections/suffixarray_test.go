@@ -0,0 +1,47 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// naiveSuffixArray sorts the suffix offsets by comparing the suffixes directly
+func naiveSuffixArray[T Symbol](text []T) []int {
+	sa := make([]int, len(text))
+	for i := range sa {
+		sa[i] = i
+	}
+	slices.SortFunc(sa, func(a, b int) int { return slices.Compare(text[a:], text[b:]) })
+	return sa
+}
+
+func TestSuffixArrayOrder(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	texts := []string{"", "a", "banana", "mississippi", "aaaa", "abab", "\x00\x00\x01\x00"}
+	for range 200 {
+		b := make([]byte, rng.IntN(40))
+		for i := range b {
+			b[i] = byte(rng.IntN(3))
+		}
+		texts = append(texts, string(b))
+	}
+	for _, text := range texts {
+		sa := NewSuffixArray([]byte(text))
+		want := naiveSuffixArray([]byte(text))
+		got := make([]int, sa.Len())
+		for i := range got {
+			got[i] = sa.Suffix(i)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("suffix array of %q = %v, want %v", text, got, want)
+		}
+	}
+	runes := []rune("日本語の日本")
+	sa := NewSuffixArray(runes)
+	for i, want := range naiveSuffixArray(runes) {
+		if got := sa.Suffix(i); got != want {
+			t.Errorf("rune suffix %d = %d, want %d", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncCounter counts occurrences per key and is safe for concurrent use.
+// Increments of existing keys only take a read lock and update the count atomically.
+type SyncCounter[K comparable] struct {
+	mu     sync.RWMutex
+	counts map[K]*atomic.Int64
+}
+
+func NewSyncCounter[K comparable]() *SyncCounter[K] {
+	return &SyncCounter[K]{counts: make(map[K]*atomic.Int64)}
+}
+
+// Add adds delta to the count of key and returns the new count
+func (c *SyncCounter[K]) Add(key K, delta int64) int64 {
+	c.mu.RLock()
+	n, ok := c.counts[key]
+	c.mu.RUnlock()
+	if !ok {
+		c.mu.Lock()
+		if n, ok = c.counts[key]; !ok {
+			n = new(atomic.Int64)
+			c.counts[key] = n
+		}
+		c.mu.Unlock()
+	}
+	return n.Add(delta)
+}
+
+func (c *SyncCounter[K]) Get(key K) int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if n, ok := c.counts[key]; ok {
+		return n.Load()
+	}
+	return 0
+}
+
+// Snapshot returns a copy of all current counts
+func (c *SyncCounter[K]) Snapshot() map[K]int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m := make(map[K]int64, len(c.counts))
+	for k, n := range c.counts {
+		m[k] = n.Load()
+	}
+	return m
+}
+
+// ByCount returns an iterator over a snapshot of the counts, highest count first
+func (c *SyncCounter[K]) ByCount() iter.Seq2[K, int64] {
+	return func(yield func(K, int64) bool) {
+		m := c.Snapshot()
+		keys := slices.SortedFunc(maps.Keys(m), func(a, b K) int {
+			return cmp.Compare(m[b], m[a])
+		})
+		for _, k := range keys {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
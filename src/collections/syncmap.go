@@ -0,0 +1,72 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+	"sync"
+)
+
+// SyncMap is a map safe for concurrent use by multiple goroutines
+type SyncMap[K comparable, V any] struct {
+	mu   sync.RWMutex
+	data map[K]V
+}
+
+func NewSyncMap[K comparable, V any]() *SyncMap[K, V] {
+	return &SyncMap[K, V]{data: make(map[K]V)}
+}
+
+func (m *SyncMap[K, V]) Load(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.data[key]
+	return v, ok
+}
+
+func (m *SyncMap[K, V]) Store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = value
+}
+
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.data, key)
+}
+
+// LoadOrStore returns the existing value for key if present, otherwise it stores and returns value.
+// The boolean is true if the value was loaded.
+func (m *SyncMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.data[key]; ok {
+		return v, true
+	}
+	m.data[key] = value
+	return value, false
+}
+
+func (m *SyncMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.data)
+}
+
+// Snapshot returns a copy of the current contents, usable with the CollectMap* helpers
+func (m *SyncMap[K, V]) Snapshot() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return maps.Clone(m.data)
+}
+
+// All() Function returns an iterator over a consistent snapshot of the map
+func (m *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.Snapshot() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
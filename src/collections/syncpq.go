@@ -0,0 +1,69 @@
+package collections
+
+import (
+	"context"
+	"sync"
+)
+
+// SyncPriorityQueue is a priority queue safe for concurrent use, popping the element with the highest priority first
+type SyncPriorityQueue[T any] struct {
+	mu      sync.Mutex
+	pq      PriorityQueue[T]
+	waiters int
+	notify  chan struct{}
+}
+
+// NewSyncPriorityQueue creates a queue where less(a, b) means a has a higher priority than b
+func NewSyncPriorityQueue[T any](less func(a, b T) bool) *SyncPriorityQueue[T] {
+	return &SyncPriorityQueue[T]{pq: PriorityQueue[T]{less: less}, notify: make(chan struct{})}
+}
+
+func (q *SyncPriorityQueue[T]) Push(value T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pq.Push(value)
+	if q.waiters > 0 {
+		close(q.notify)
+		q.notify = make(chan struct{})
+	}
+}
+
+// TryPop removes and returns the element with the highest priority without blocking
+func (q *SyncPriorityQueue[T]) TryPop() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pq.Pop()
+}
+
+// PopWait removes and returns the element with the highest priority, waiting for one until ctx is canceled
+func (q *SyncPriorityQueue[T]) PopWait(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if v, ok := q.pq.Pop(); ok {
+			q.mu.Unlock()
+			return v, nil
+		}
+		q.waiters++
+		notify := q.notify
+		q.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+		}
+
+		q.mu.Lock()
+		q.waiters--
+		q.mu.Unlock()
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+func (q *SyncPriorityQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pq.Len()
+}
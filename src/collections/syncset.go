@@ -0,0 +1,96 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+	"sync"
+)
+
+// SyncSet is a set safe for concurrent use by multiple goroutines
+type SyncSet[T comparable] struct {
+	mu   sync.RWMutex
+	data map[T]struct{}
+}
+
+func NewSyncSet[T comparable]() *SyncSet[T] {
+	return &SyncSet[T]{data: make(map[T]struct{})}
+}
+
+// Add inserts value and reports whether it was not already present
+func (s *SyncSet[T]) Add(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data[value]; ok {
+		return false
+	}
+	s.data[value] = struct{}{}
+	return true
+}
+
+// AddAll inserts every element of seq under a single lock
+func (s *SyncSet[T]) AddAll(seq iter.Seq[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for v := range seq {
+		s.data[v] = struct{}{}
+	}
+}
+
+// Remove deletes value and reports whether it was present
+func (s *SyncSet[T]) Remove(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data[value]; !ok {
+		return false
+	}
+	delete(s.data, value)
+	return true
+}
+
+func (s *SyncSet[T]) Contains(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.data[value]
+	return ok
+}
+
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+func (s *SyncSet[T]) snapshot() map[T]struct{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return maps.Clone(s.data)
+}
+
+// Union returns a new set containing the elements of both sets
+func (s *SyncSet[T]) Union(other *SyncSet[T]) *SyncSet[T] {
+	data := s.snapshot()
+	maps.Copy(data, other.snapshot())
+	return &SyncSet[T]{data: data}
+}
+
+// Intersect returns a new set containing the elements present in both sets
+func (s *SyncSet[T]) Intersect(other *SyncSet[T]) *SyncSet[T] {
+	o := other.snapshot()
+	data := s.snapshot()
+	maps.DeleteFunc(data, func(v T, _ struct{}) bool {
+		_, ok := o[v]
+		return !ok
+	})
+	return &SyncSet[T]{data: data}
+}
+
+// All() Function returns an iterator over a snapshot of the set
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
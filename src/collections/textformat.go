@@ -0,0 +1,74 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// TextFormat controls how collections render in String, MarshalText and FormatWith.
+// The method is not called Format because that name is reserved for fmt.Formatter.
+type TextFormat struct {
+	// Sep goes between elements and defaults to a single space
+	Sep string
+	// Max limits the number of elements shown, zero or negative shows all
+	Max int
+	// More replaces the elements left out by Max and defaults to "..."
+	More string
+}
+
+// formatElements renders seq of n elements in brackets according to f
+func formatElements(seq iter.Seq[string], n int, f TextFormat) string {
+	f.Sep = cmp.Or(f.Sep, " ")
+	f.More = cmp.Or(f.More, "...")
+	var sb strings.Builder
+	sb.WriteByte('[')
+	i := 0
+	for s := range seq {
+		if f.Max > 0 && i == f.Max {
+			break
+		}
+		if i > 0 {
+			sb.WriteString(f.Sep)
+		}
+		sb.WriteString(s)
+		i++
+	}
+	if i < n {
+		if i > 0 {
+			sb.WriteString(f.Sep)
+		}
+		fmt.Fprintf(&sb, "%s(%d more)", f.More, n-i)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// sprintSeq formats every element with fmt's default formatting
+func sprintSeq[T any](seq iter.Seq[T]) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for v := range seq {
+			if !yield(fmt.Sprint(v)) {
+				return
+			}
+		}
+	}
+}
+
+// sprintEntries formats every entry as key:value
+func sprintEntries[K, V any](seq iter.Seq2[K, V]) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for k, v := range seq {
+			if !yield(fmt.Sprintf("%v:%v", k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// sortedStrings formats the elements of an unordered collection and sorts them for deterministic output
+func sortedStrings[T any](seq iter.Seq[T]) iter.Seq[string] {
+	return slices.Values(slices.Sorted(sprintSeq(seq)))
+}
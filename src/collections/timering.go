@@ -0,0 +1,100 @@
+package collections
+
+import (
+	"iter"
+	"sort"
+	"time"
+)
+
+// TimeRing keeps the most recent timestamped values of a series, bounded both by count and by age.
+// Entries must be pushed in time order.
+type TimeRing[T any] struct {
+	ring   *RingBuffer[Pair[time.Time, T]]
+	maxAge time.Duration
+}
+
+// NewTimeRing creates a ring holding at most capacity entries. If maxAge is positive, entries older
+// than maxAge relative to the newest entry are dropped as well.
+func NewTimeRing[T any](capacity int, maxAge time.Duration) *TimeRing[T] {
+	return &TimeRing[T]{ring: NewRingBuffer[Pair[time.Time, T]](capacity, OverwriteOldest), maxAge: maxAge}
+}
+
+// Push appends value at t, evicting the oldest entries if needed. It reports false and stores nothing
+// if t is before the newest entry.
+func (r *TimeRing[T]) Push(t time.Time, value T) bool {
+	if newest, ok := r.Newest(); ok && t.Before(newest.Key) {
+		return false
+	}
+	r.ring.Push(Pair[time.Time, T]{t, value})
+	if r.maxAge > 0 {
+		r.EvictBefore(t.Add(-r.maxAge))
+	}
+	return true
+}
+
+// EvictBefore drops all entries with a timestamp before t and returns how many were dropped
+func (r *TimeRing[T]) EvictBefore(t time.Time) int {
+	n := 0
+	for oldest, ok := r.ring.Peek(); ok && oldest.Key.Before(t); oldest, ok = r.ring.Peek() {
+		r.ring.Pop()
+		n++
+	}
+	return n
+}
+
+func (r *TimeRing[T]) Len() int {
+	return r.ring.Len()
+}
+
+// Oldest returns the earliest entry still stored
+func (r *TimeRing[T]) Oldest() (Pair[time.Time, T], bool) {
+	return r.ring.Peek()
+}
+
+// Newest returns the latest entry
+func (r *TimeRing[T]) Newest() (Pair[time.Time, T], bool) {
+	if r.ring.Len() == 0 {
+		return Pair[time.Time, T]{}, false
+	}
+	return r.ring.At(r.ring.Len() - 1), true
+}
+
+// search returns the index of the first entry not before t
+func (r *TimeRing[T]) search(t time.Time) int {
+	return sort.Search(r.ring.Len(), func(i int) bool { return !r.ring.At(i).Key.Before(t) })
+}
+
+// Since returns an iterator over the entries at or after t in time order
+func (r *TimeRing[T]) Since(t time.Time) iter.Seq2[time.Time, T] {
+	return func(yield func(time.Time, T) bool) {
+		for i := r.search(t); i < r.ring.Len(); i++ {
+			e := r.ring.At(i)
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries with from <= timestamp < to in time order
+func (r *TimeRing[T]) Range(from, to time.Time) iter.Seq2[time.Time, T] {
+	return func(yield func(time.Time, T) bool) {
+		for i := r.search(from); i < r.ring.Len(); i++ {
+			e := r.ring.At(i)
+			if !e.Key.Before(to) || !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all entries in time order
+func (r *TimeRing[T]) All() iter.Seq2[time.Time, T] {
+	return func(yield func(time.Time, T) bool) {
+		for e := range r.ring.All() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
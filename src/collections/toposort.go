@@ -0,0 +1,100 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrUndirected is returned by algorithms that require a directed graph
+var ErrUndirected = errors.New("graph is undirected")
+
+// CycleError is returned by TopoSort and lists the nodes of one cycle, starting and ending with the same node
+type CycleError[N comparable] struct {
+	Cycle []N
+}
+
+func (e *CycleError[N]) Error() string {
+	return fmt.Sprintf("graph has a cycle: %v", e.Cycle)
+}
+
+// TopoSort returns the nodes of a directed acyclic graph so that every node comes before the nodes its edges point to.
+// Independent nodes keep their insertion order.
+func TopoSort[N comparable](g *Graph[N]) (iter.Seq[N], error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+	indegree := make(map[N]int, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, e := range g.adj[n] {
+			indegree[e.To]++
+		}
+	}
+	q := NewQueue[N]()
+	for _, n := range g.nodes {
+		if indegree[n] == 0 {
+			q.Enqueue(n)
+		}
+	}
+	order := make([]N, 0, len(g.nodes))
+	for !q.IsEmpty() {
+		n, _ := q.Dequeue()
+		order = append(order, n)
+		for _, e := range g.adj[n] {
+			if indegree[e.To]--; indegree[e.To] == 0 {
+				q.Enqueue(e.To)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, &CycleError[N]{Cycle: findCycle(g, indegree)}
+	}
+	return func(yield func(N) bool) {
+		for _, n := range order {
+			if !yield(n) {
+				return
+			}
+		}
+	}, nil
+}
+
+// findCycle searches the nodes left with a positive in-degree for a cycle
+func findCycle[N comparable](g *Graph[N], indegree map[N]int) []N {
+	const (
+		unvisited = iota
+		active
+		finished
+	)
+	state := make(map[N]int)
+	var stack []N
+	var visit func(n N) []N
+	visit = func(n N) []N {
+		state[n] = active
+		stack = append(stack, n)
+		for _, e := range g.adj[n] {
+			switch state[e.To] {
+			case active:
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == e.To {
+						return append(append([]N(nil), stack[i:]...), e.To)
+					}
+				}
+			case unvisited:
+				if c := visit(e.To); c != nil {
+					return c
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[n] = finished
+		return nil
+	}
+	for _, n := range g.nodes {
+		if indegree[n] > 0 && state[n] == unvisited {
+			if c := visit(n); c != nil {
+				return c
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+// Treap is a sorted map backed by a randomized binary search tree that supports splitting and merging
+type Treap[K cmp.Ordered, V any] struct {
+	root  *treapNode[K, V]
+	nodes *Arena[treapNode[K, V]]
+	rng   *rand.Rand
+}
+
+type treapNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       V
+	priority    uint64
+	size        int
+	left, right *treapNode[K, V]
+}
+
+func NewTreap[K cmp.Ordered, V any]() *Treap[K, V] {
+	return &Treap[K, V]{}
+}
+
+// NewTreapWithArena creates a treap that allocates its nodes in blocks of blockSize
+func NewTreapWithArena[K cmp.Ordered, V any](blockSize int) *Treap[K, V] {
+	return &Treap[K, V]{nodes: NewArena[treapNode[K, V]](blockSize)}
+}
+
+// SetRand makes t draw node priorities from rng, so that the tree shape is reproducible.
+// A nil rng uses the global source.
+func (t *Treap[K, V]) SetRand(rng *rand.Rand) {
+	t.rng = rng
+}
+
+func (n *treapNode[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treapNode[K, V]) update() *treapNode[K, V] {
+	n.size = 1 + n.left.len() + n.right.len()
+	return n
+}
+
+// splitTreap splits n into the nodes with keys less than key and the nodes with keys greater than or equal to key
+func splitTreap[K cmp.Ordered, V any](n *treapNode[K, V], key K) (*treapNode[K, V], *treapNode[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if n.key < key {
+		l, r := splitTreap(n.right, key)
+		n.right = l
+		return n.update(), r
+	}
+	l, r := splitTreap(n.left, key)
+	n.left = r
+	return l, n.update()
+}
+
+// mergeTreap joins l and r, where all keys in l must be less than all keys in r
+func mergeTreap[K cmp.Ordered, V any](l, r *treapNode[K, V]) *treapNode[K, V] {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.priority > r.priority:
+		l.right = mergeTreap(l.right, r)
+		return l.update()
+	default:
+		r.left = mergeTreap(l, r.left)
+		return r.update()
+	}
+}
+
+func (t *Treap[K, V]) find(key K) *treapNode[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (t *Treap[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *Treap[K, V]) Has(key K) bool {
+	return t.find(key) != nil
+}
+
+func (t *Treap[K, V]) Set(key K, value V) {
+	if n := t.find(key); n != nil {
+		n.value = value
+		return
+	}
+	l, r := splitTreap(t.root, key)
+	n := t.nodes.New()
+	n.key, n.value, n.priority, n.size = key, value, randUint64(t.rng), 1
+	t.root = mergeTreap(mergeTreap(l, n), r)
+}
+
+// Delete removes key and reports whether it was present
+func (t *Treap[K, V]) Delete(key K) bool {
+	if !t.Has(key) {
+		return false
+	}
+	t.root = t.delete(t.root, key)
+	return true
+}
+
+// delete removes key, which must be present in the subtree of n
+func (t *Treap[K, V]) delete(n *treapNode[K, V], key K) *treapNode[K, V] {
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = t.delete(n.left, key)
+	case c > 0:
+		n.right = t.delete(n.right, key)
+	default:
+		merged := mergeTreap(n.left, n.right)
+		t.nodes.Free(n)
+		return merged
+	}
+	return n.update()
+}
+
+// free returns all nodes of the subtree of n to the arena
+func (t *Treap[K, V]) free(n *treapNode[K, V]) {
+	if n != nil && t.nodes != nil {
+		t.free(n.left)
+		t.free(n.right)
+		t.nodes.Free(n)
+	}
+}
+
+// Split moves all entries with keys greater than or equal to key into a new treap and returns it.
+// The new treap shares the arena and random source of t, so the two must not be used concurrently.
+func (t *Treap[K, V]) Split(key K) *Treap[K, V] {
+	l, r := splitTreap(t.root, key)
+	t.root = l
+	return &Treap[K, V]{root: r, nodes: t.nodes, rng: t.rng}
+}
+
+// Merge moves all entries of other into t, leaving other empty.
+// It fails with ErrUnsorted unless all keys of t are less than all keys of other.
+func (t *Treap[K, V]) Merge(other *Treap[K, V]) error {
+	if t == other || other.root == nil {
+		return nil
+	}
+	if last, _, ok := t.Last(); ok {
+		if first, _, _ := other.First(); first <= last {
+			return ErrUnsorted
+		}
+	}
+	t.root = mergeTreap(t.root, other.root)
+	other.root = nil
+	return nil
+}
+
+// DeleteRange removes all entries with keys in [lo, hi) and returns how many were removed
+func (t *Treap[K, V]) DeleteRange(lo, hi K) int {
+	if hi <= lo {
+		return 0
+	}
+	l, r := splitTreap(t.root, lo)
+	mid, r := splitTreap(r, hi)
+	t.root = mergeTreap(l, r)
+	n := mid.len()
+	t.free(mid)
+	return n
+}
+
+func (t *Treap[K, V]) Len() int {
+	return t.root.len()
+}
+
+func (t *Treap[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// First returns the entry with the smallest key
+func (t *Treap[K, V]) First() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+// Last returns the entry with the largest key
+func (t *Treap[K, V]) Last() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (t *Treap[K, V]) Floor(key K) (K, V, bool) {
+	var best *treapNode[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key
+func (t *Treap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *treapNode[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			best = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// walk yields the entries of the subtree of n with keys in [lo, hi) and reports whether to continue
+func (n *treapNode[K, V]) walk(lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if lo == nil || n.key >= *lo {
+		if !n.left.walk(lo, hi, yield) {
+			return false
+		}
+		if hi != nil && n.key >= *hi {
+			return false
+		}
+		if !yield(n.key, n.value) {
+			return false
+		}
+	}
+	return n.right.walk(lo, hi, yield)
+}
+
+// All() Function returns an iterator over all entries in ascending key order
+func (t *Treap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.walk(nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi) in ascending order
+func (t *Treap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.walk(&lo, &hi, yield)
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (t *Treap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range t.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Marshal encodes the entries in ascending key order with codec
+func (t *Treap[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return marshalEntries(t.Len(), t.All(), codec)
+}
+
+// Unmarshal replaces the contents of t with data produced by Marshal using the same codec
+func (t *Treap[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	t.root = nil
+	t.nodes.Reset()
+	for _, e := range entries {
+		t.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// Validate checks the search order on keys, the heap order on priorities and the cached subtree sizes
+func (t *Treap[K, V]) Validate() error {
+	return t.root.validate(nil, nil)
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (t *Treap[K, V]) CheckInvariants() {
+	checkInvariants(t)
+}
+
+func (n *treapNode[K, V]) validate(lo, hi *K) error {
+	if n == nil {
+		return nil
+	}
+	if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+		return invariantf("key %v out of order", n.key)
+	}
+	for _, c := range [2]*treapNode[K, V]{n.left, n.right} {
+		if c != nil && c.priority > n.priority {
+			return invariantf("priority of %v exceeds its parent %v", c.key, n.key)
+		}
+	}
+	if want := 1 + n.left.len() + n.right.len(); n.size != want {
+		return invariantf("size %d at %v, want %d", n.size, n.key, want)
+	}
+	if err := n.left.validate(lo, &n.key); err != nil {
+		return err
+	}
+	return n.right.validate(&n.key, hi)
+}
@@ -0,0 +1,66 @@
+package collections
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestTreapSplitMergeDeleteRange(t *testing.T) {
+	treap := NewTreapWithArena[int, int](16)
+	treap.SetRand(rand.New(rand.NewPCG(1, 2)))
+	for i := range 100 {
+		treap.Set(i, i*i)
+	}
+
+	upper := treap.Split(50)
+	if upper.nodes != treap.nodes || upper.rng != treap.rng {
+		t.Error("Split did not keep the arena and random source")
+	}
+	if got := slices.Collect(treap.Keys()); !slices.Equal(got, intRange(0, 50)) {
+		t.Errorf("lower keys = %v", got)
+	}
+	if got := slices.Collect(upper.Keys()); !slices.Equal(got, intRange(50, 100)) {
+		t.Errorf("upper keys = %v", got)
+	}
+	if err := errors.Join(treap.Validate(), upper.Validate()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := upper.Merge(treap); !errors.Is(err, ErrUnsorted) {
+		t.Errorf("Merge of overlapping key ranges = %v, want ErrUnsorted", err)
+	}
+	if err := treap.Merge(upper); err != nil {
+		t.Fatal(err)
+	}
+	if !upper.IsEmpty() || treap.Len() != 100 {
+		t.Errorf("after Merge: len %d and %d, want 100 and 0", treap.Len(), upper.Len())
+	}
+
+	if n := treap.DeleteRange(20, 80); n != 60 {
+		t.Errorf("DeleteRange removed %d entries, want 60", n)
+	}
+	want := append(intRange(0, 20), intRange(80, 100)...)
+	if got := slices.Collect(treap.Keys()); !slices.Equal(got, want) {
+		t.Errorf("keys after DeleteRange = %v", got)
+	}
+	if n := treap.DeleteRange(90, 90); n != 0 {
+		t.Errorf("DeleteRange of an empty range removed %d entries", n)
+	}
+	if v, ok := treap.Get(85); !ok || v != 85*85 {
+		t.Errorf("Get(85) = %d, %v", v, ok)
+	}
+	if err := treap.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// intRange returns the integers in [lo, hi)
+func intRange(lo, hi int) []int {
+	s := make([]int, 0, hi-lo)
+	for i := lo; i < hi; i++ {
+		s = append(s, i)
+	}
+	return s
+}
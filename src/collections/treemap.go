@@ -0,0 +1,431 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// TreeMap is a sorted map backed by a left-leaning red-black tree
+type TreeMap[K cmp.Ordered, V any] struct {
+	root  *rbNode[K, V]
+	size  int
+	nodes *Arena[rbNode[K, V]]
+}
+
+type rbNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *rbNode[K, V]
+	red         bool
+}
+
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return &TreeMap[K, V]{}
+}
+
+// NewTreeMapWithArena creates a tree map that allocates its nodes in blocks of blockSize
+func NewTreeMapWithArena[K cmp.Ordered, V any](blockSize int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{nodes: NewArena[rbNode[K, V]](blockSize)}
+}
+
+func isRed[K cmp.Ordered, V any](n *rbNode[K, V]) bool {
+	return n != nil && n.red
+}
+
+func (n *rbNode[K, V]) rotateLeft() *rbNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+func (n *rbNode[K, V]) rotateRight() *rbNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+func (n *rbNode[K, V]) flipColors() {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+func (n *rbNode[K, V]) balance() *rbNode[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = n.rotateLeft()
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = n.rotateRight()
+	}
+	if isRed(n.left) && isRed(n.right) {
+		n.flipColors()
+	}
+	return n
+}
+
+func (n *rbNode[K, V]) moveRedLeft() *rbNode[K, V] {
+	n.flipColors()
+	if isRed(n.right.left) {
+		n.right = n.right.rotateRight()
+		n = n.rotateLeft()
+		n.flipColors()
+	}
+	return n
+}
+
+func (n *rbNode[K, V]) moveRedRight() *rbNode[K, V] {
+	n.flipColors()
+	if isRed(n.left.left) {
+		n = n.rotateRight()
+		n.flipColors()
+	}
+	return n
+}
+
+func (m *TreeMap[K, V]) find(key K) *rbNode[K, V] {
+	n := m.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	if n := m.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *TreeMap[K, V]) Has(key K) bool {
+	return m.find(key) != nil
+}
+
+func (m *TreeMap[K, V]) Set(key K, value V) {
+	m.root = m.set(m.root, key, value)
+	m.root.red = false
+}
+
+func (m *TreeMap[K, V]) set(n *rbNode[K, V], key K, value V) *rbNode[K, V] {
+	if n == nil {
+		m.size++
+		n = m.nodes.New()
+		n.key, n.value, n.red = key, value, true
+		return n
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = m.set(n.left, key, value)
+	case c > 0:
+		n.right = m.set(n.right, key, value)
+	default:
+		n.value = value
+	}
+	return n.balance()
+}
+
+// Delete removes key and reports whether it was present
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	if m.find(key) == nil {
+		return false
+	}
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.size--
+	return true
+}
+
+// delete removes key, which must be present in the subtree of n
+func (m *TreeMap[K, V]) delete(n *rbNode[K, V], key K) *rbNode[K, V] {
+	if key < n.key {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = n.moveRedLeft()
+		}
+		n.left = m.delete(n.left, key)
+		return n.balance()
+	}
+	if isRed(n.left) {
+		n = n.rotateRight()
+	}
+	if key == n.key && n.right == nil {
+		m.nodes.Free(n)
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = n.moveRedRight()
+	}
+	if key == n.key {
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.key, n.value = successor.key, successor.value
+		n.right = m.deleteMin(n.right)
+	} else {
+		n.right = m.delete(n.right, key)
+	}
+	return n.balance()
+}
+
+func (m *TreeMap[K, V]) deleteMin(n *rbNode[K, V]) *rbNode[K, V] {
+	if n.left == nil {
+		m.nodes.Free(n)
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = n.moveRedLeft()
+	}
+	n.left = m.deleteMin(n.left)
+	return n.balance()
+}
+
+func (m *TreeMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// First returns the entry with the smallest key
+func (m *TreeMap[K, V]) First() (K, V, bool) {
+	n := m.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+// Last returns the entry with the largest key
+func (m *TreeMap[K, V]) Last() (K, V, bool) {
+	n := m.root
+	if n == nil {
+		return zeroEntry[K, V]()
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var best *rbNode[K, V]
+	for n := m.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *rbNode[K, V]
+	for n := m.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			best = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if best == nil {
+		return zeroEntry[K, V]()
+	}
+	return best.key, best.value, true
+}
+
+func zeroEntry[K, V any]() (K, V, bool) {
+	var (
+		k K
+		v V
+	)
+	return k, v, false
+}
+
+// All() Function returns an iterator over all entries in ascending key order
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stack []*rbNode[K, V]
+		for n := m.root; n != nil || len(stack) > 0; n = n.right {
+			for ; n != nil; n = n.left {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all entries in descending key order
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stack []*rbNode[K, V]
+		for n := m.root; n != nil || len(stack) > 0; n = n.left {
+			for ; n != nil; n = n.right {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi) in ascending order
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stack []*rbNode[K, V]
+		// Only descend into the parts of the tree that can contain keys >= lo
+		push := func(n *rbNode[K, V]) {
+			for n != nil {
+				if n.key < lo {
+					n = n.right
+				} else {
+					stack = append(stack, n)
+					n = n.left
+				}
+			}
+		}
+		push(m.root)
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if n.key >= hi || !yield(n.key, n.value) {
+				return
+			}
+			push(n.right)
+		}
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Marshal encodes the entries in ascending key order with codec
+func (m *TreeMap[K, V]) Marshal(codec Codec[Pair[K, V]]) ([]byte, error) {
+	return marshalEntries(m.Len(), m.All(), codec)
+}
+
+// Unmarshal replaces the contents of m with data produced by Marshal using the same codec
+func (m *TreeMap[K, V]) Unmarshal(data []byte, codec Codec[Pair[K, V]]) error {
+	entries, err := decodeElements(data, codec)
+	if err != nil {
+		return err
+	}
+	m.root, m.size = nil, 0
+	m.nodes.Reset()
+	for _, e := range entries {
+		m.Set(e.Key, e.Value)
+	}
+	return nil
+}
+
+// FormatWith renders the elements as key:value in ascending key order according to f
+func (m *TreeMap[K, V]) FormatWith(f TextFormat) string {
+	return formatElements(sprintEntries(m.All()), m.Len(), f)
+}
+
+func (m *TreeMap[K, V]) String() string {
+	return m.FormatWith(TextFormat{})
+}
+
+func (m *TreeMap[K, V]) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Validate checks the search order, the left-leaning red-black rules and the cached size
+func (m *TreeMap[K, V]) Validate() error {
+	if isRed(m.root) {
+		return invariantf("red root")
+	}
+	n, _, err := m.root.validate(nil, nil)
+	if err == nil && n != m.size {
+		err = invariantf("size %d, but %d nodes", m.size, n)
+	}
+	return err
+}
+
+// CheckInvariants panics with the error returned by Validate
+func (m *TreeMap[K, V]) CheckInvariants() {
+	checkInvariants(m)
+}
+
+// validate returns the number of nodes and the black height of the subtree rooted at n
+func (n *rbNode[K, V]) validate(lo, hi *K) (count, black int, err error) {
+	if n == nil {
+		return 0, 1, nil
+	}
+	if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+		return 0, 0, invariantf("key %v out of order", n.key)
+	}
+	if isRed(n.right) {
+		return 0, 0, invariantf("right-leaning red link at %v", n.key)
+	}
+	if n.red && isRed(n.left) {
+		return 0, 0, invariantf("two red links in a row at %v", n.key)
+	}
+	lc, lb, err := n.left.validate(lo, &n.key)
+	if err != nil {
+		return 0, 0, err
+	}
+	rc, rb, err := n.right.validate(&n.key, hi)
+	if err != nil {
+		return 0, 0, err
+	}
+	if lb != rb {
+		return 0, 0, invariantf("black heights %d and %d differ at %v", lb, rb, n.key)
+	}
+	if !n.red {
+		lb++
+	}
+	return lc + rc + 1, lb, nil
+}
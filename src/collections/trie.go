@@ -0,0 +1,138 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+)
+
+// Trie maps string keys to values and supports prefix queries. Keys are split into bytes.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+type trieNode[V any] struct {
+	// children is kept sorted by label so that iteration is in lexicographic order
+	children []trieEdge[V]
+	value    V
+	terminal bool
+}
+
+type trieEdge[V any] struct {
+	label byte
+	node  *trieNode[V]
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+func (n *trieNode[V]) child(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(e trieEdge[V], b byte) int {
+		return int(e.label) - int(b)
+	})
+}
+
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		j, ok := n.child(key[i])
+		if !ok {
+			return nil
+		}
+		n = n.children[j].node
+	}
+	return n
+}
+
+// Insert stores value for key and reports whether key is new
+func (t *Trie[V]) Insert(key string, value V) bool {
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		j, ok := n.child(key[i])
+		if !ok {
+			n.children = slices.Insert(n.children, j, trieEdge[V]{key[i], &trieNode[V]{}})
+		}
+		n = n.children[j].node
+	}
+	n.value = value
+	if n.terminal {
+		return false
+	}
+	n.terminal = true
+	t.size++
+	return true
+}
+
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.terminal {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key, pruning nodes that no longer lead to any key, and reports whether it was present
+func (t *Trie[V]) Delete(key string) bool {
+	path := []*trieNode[V]{&t.root}
+	for i := 0; i < len(key); i++ {
+		j, ok := path[i].child(key[i])
+		if !ok {
+			return false
+		}
+		path = append(path, path[i].children[j].node)
+	}
+	n := path[len(path)-1]
+	if !n.terminal {
+		return false
+	}
+	var zero V
+	n.value, n.terminal = zero, false
+	t.size--
+
+	for i := len(key); i > 0; i-- {
+		if n := path[i]; n.terminal || len(n.children) > 0 {
+			break
+		}
+		parent := path[i-1]
+		j, _ := parent.child(key[i-1])
+		parent.children = slices.Delete(parent.children, j, j+1)
+	}
+	return true
+}
+
+// HasPrefix reports whether any key starts with prefix
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	n := t.find(prefix)
+	return n != nil && (n.terminal || len(n.children) > 0)
+}
+
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+func (n *trieNode[V]) walk(key []byte, yield func(string, V) bool) bool {
+	if n.terminal && !yield(string(key), n.value) {
+		return false
+	}
+	for _, e := range n.children {
+		if !e.node.walk(append(key, e.label), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// WalkPrefix returns an iterator over all keys starting with prefix in lexicographic order
+func (t *Trie[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if n := t.find(prefix); n != nil {
+			n.walk([]byte(prefix), yield)
+		}
+	}
+}
+
+// All() Function returns an iterator over all entries in lexicographic key order
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.WalkPrefix("")
+}
@@ -0,0 +1,155 @@
+package collections
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+)
+
+type ttlEntry[V any] struct {
+	value V
+	// expires is the zero time for entries that never expire
+	expires time.Time
+}
+
+func (e ttlEntry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// TTLCache is a concurrency-safe cache whose entries expire after a duration.
+// Expired entries are removed lazily on access, or in the background by StartJanitor.
+type TTLCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	entries    map[K]ttlEntry[V]
+	defaultTTL time.Duration
+	onExpire   func(K, V)
+}
+
+// NewTTLCache creates a cache whose entries expire after defaultTTL. A non-positive duration disables expiry.
+func NewTTLCache[K comparable, V any](defaultTTL time.Duration) *TTLCache[K, V] {
+	return &TTLCache[K, V]{entries: make(map[K]ttlEntry[V]), defaultTTL: defaultTTL}
+}
+
+// OnExpire registers fn to be called with every entry that is removed because it expired
+func (c *TTLCache[K, V]) OnExpire(fn func(key K, value V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onExpire = fn
+}
+
+// Put stores value for key with the default TTL
+func (c *TTLCache[K, V]) Put(key K, value V) {
+	c.PutTTL(key, value, c.defaultTTL)
+}
+
+// PutTTL stores value for key, expiring after ttl. A non-positive ttl means the entry never expires.
+func (c *TTLCache[K, V]) PutTTL(key K, value V, ttl time.Duration) {
+	e := ttlEntry[V]{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = e
+}
+
+// Get returns the value for key if it is present and not expired
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok && e.expired(time.Now()) {
+		delete(c.entries, key)
+		fn := c.onExpire
+		c.mu.Unlock()
+		if fn != nil {
+			fn(key, e.value)
+		}
+		var zero V
+		return zero, false
+	}
+	c.mu.Unlock()
+	return e.value, ok
+}
+
+// Remove deletes key without calling the expiry callback and reports whether it was present
+func (c *TTLCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[key]
+	delete(c.entries, key)
+	return ok
+}
+
+// Len returns the number of stored entries, including expired ones that were not removed yet
+func (c *TTLCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Flush removes all entries without calling the expiry callback
+func (c *TTLCache[K, V]) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// DeleteExpired removes all expired entries and returns how many were removed
+func (c *TTLCache[K, V]) DeleteExpired() int {
+	now := time.Now()
+	var expired []Pair[K, V]
+	c.mu.Lock()
+	for k, e := range c.entries {
+		if e.expired(now) {
+			delete(c.entries, k)
+			expired = append(expired, Pair[K, V]{k, e.value})
+		}
+	}
+	fn := c.onExpire
+	c.mu.Unlock()
+
+	// Callbacks run without the lock so they may use the cache
+	if fn != nil {
+		for _, p := range expired {
+			fn(p.Key, p.Value)
+		}
+	}
+	return len(expired)
+}
+
+// StartJanitor removes expired entries every interval in a background goroutine until ctx is done
+func (c *TTLCache[K, V]) StartJanitor(ctx context.Context, interval time.Duration) {
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				c.DeleteExpired()
+			}
+		}
+	}()
+}
+
+// All returns an iterator over a snapshot of the entries that are not expired
+func (c *TTLCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := time.Now()
+		var live []Pair[K, V]
+		c.mu.Lock()
+		for k, e := range c.entries {
+			if !e.expired(now) {
+				live = append(live, Pair[K, V]{k, e.value})
+			}
+		}
+		c.mu.Unlock()
+		for _, p := range live {
+			if !yield(p.Key, p.Value) {
+				return
+			}
+		}
+	}
+}
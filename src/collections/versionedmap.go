@@ -0,0 +1,111 @@
+package collections
+
+import "iter"
+
+// VersionedMap is a map in which every write creates a new version. Earlier versions stay readable
+// through Snapshot. Versions share structure through PersistentMap, so a write costs O(log n).
+type VersionedMap[K comparable, V any] struct {
+	// versions[i] is the map at version first+i
+	versions []*PersistentMap[K, V]
+	first    uint64
+}
+
+// MapSnapshot is a read-only view of a VersionedMap at one version
+type MapSnapshot[K comparable, V any] struct {
+	m       *PersistentMap[K, V]
+	version uint64
+}
+
+// NewVersionedMap creates an empty map at version 0
+func NewVersionedMap[K comparable, V any]() *VersionedMap[K, V] {
+	return &VersionedMap[K, V]{versions: []*PersistentMap[K, V]{NewPersistentMap[K, V]()}}
+}
+
+func (m *VersionedMap[K, V]) current() *PersistentMap[K, V] {
+	return m.versions[len(m.versions)-1]
+}
+
+// Version returns the current version
+func (m *VersionedMap[K, V]) Version() uint64 {
+	return m.first + uint64(len(m.versions)) - 1
+}
+
+// Set stores value for key and returns the new version
+func (m *VersionedMap[K, V]) Set(key K, value V) uint64 {
+	m.versions = append(m.versions, m.current().Set(key, value))
+	return m.Version()
+}
+
+// Delete removes key and returns the new version. A version is created even if key was missing.
+func (m *VersionedMap[K, V]) Delete(key K) uint64 {
+	m.versions = append(m.versions, m.current().Delete(key))
+	return m.Version()
+}
+
+func (m *VersionedMap[K, V]) Get(key K) (V, bool) {
+	return m.current().Get(key)
+}
+
+func (m *VersionedMap[K, V]) Len() int {
+	return m.current().Len()
+}
+
+func (m *VersionedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.current().All()
+}
+
+// Snapshot returns the map as it was at version. It reports false for future or pruned versions.
+func (m *VersionedMap[K, V]) Snapshot(version uint64) (MapSnapshot[K, V], bool) {
+	if version < m.first || version > m.Version() {
+		return MapSnapshot[K, V]{}, false
+	}
+	return MapSnapshot[K, V]{m: m.versions[version-m.first], version: version}, true
+}
+
+// Latest returns a snapshot of the current version
+func (m *VersionedMap[K, V]) Latest() MapSnapshot[K, V] {
+	s, _ := m.Snapshot(m.Version())
+	return s
+}
+
+// Prune forgets all versions before version so that their memory can be reclaimed
+func (m *VersionedMap[K, V]) Prune(version uint64) {
+	version = min(version, m.Version())
+	if version <= m.first {
+		return
+	}
+	m.versions = append([]*PersistentMap[K, V](nil), m.versions[version-m.first:]...)
+	m.first = version
+}
+
+func (s MapSnapshot[K, V]) Version() uint64 {
+	return s.version
+}
+
+func (s MapSnapshot[K, V]) Get(key K) (V, bool) {
+	if s.m == nil {
+		var zero V
+		return zero, false
+	}
+	return s.m.Get(key)
+}
+
+func (s MapSnapshot[K, V]) Has(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+func (s MapSnapshot[K, V]) Len() int {
+	if s.m == nil {
+		return 0
+	}
+	return s.m.Len()
+}
+
+func (s MapSnapshot[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if s.m != nil {
+			s.m.All()(yield)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"math/rand/v2"
+)
+
+// ErrInvalidWeights is returned for weights that are negative, not finite, or do not sum to a positive value
+var ErrInvalidWeights = errors.New("invalid weights")
+
+// WeightedChooser picks random elements with probability proportional to their weight in O(1),
+// using the alias method
+type WeightedChooser[T any] struct {
+	items []T
+	prob  []float64
+	alias []int
+	rng   *rand.Rand
+}
+
+// NewWeightedChooser prepares sampling from items with the given weights in O(n)
+func NewWeightedChooser[T any](items []T, weights []float64) (*WeightedChooser[T], error) {
+	n := len(items)
+	if n == 0 || len(weights) != n {
+		return nil, ErrInvalidWeights
+	}
+	total := 0.0
+	for _, w := range weights {
+		if w < 0 || math.IsInf(w, 0) || math.IsNaN(w) {
+			return nil, ErrInvalidWeights
+		}
+		total += w
+	}
+	if total <= 0 {
+		return nil, ErrInvalidWeights
+	}
+
+	c := &WeightedChooser[T]{items: append([]T(nil), items...), prob: make([]float64, n), alias: make([]int, n)}
+	// Scale so that the average weight is 1, then pair each underfull slot with an overfull one
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		c.prob[s], c.alias[s] = scaled[s], l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// Leftovers are 1 up to rounding errors
+	for _, i := range append(small, large...) {
+		c.prob[i] = 1
+	}
+	return c, nil
+}
+
+// SetRand makes Choose draw from rng, so that the sequence of choices is reproducible.
+// A nil rng uses the global source.
+func (c *WeightedChooser[T]) SetRand(rng *rand.Rand) {
+	c.rng = rng
+}
+
+// Choose returns a random element
+func (c *WeightedChooser[T]) Choose() T {
+	i := randIntN(c.rng, len(c.items))
+	if randFloat64(c.rng) < c.prob[i] {
+		return c.items[i]
+	}
+	return c.items[c.alias[i]]
+}
+
+// WeightedSample draws k distinct elements from seq in one pass, each with probability proportional
+// to its weight. Elements with a non-positive weight are never chosen. A nil rng uses the global source.
+func WeightedSample[T any](seq iter.Seq2[T, float64], k int, rng *rand.Rand) []T {
+	if k <= 0 {
+		return nil
+	}
+	type keyed struct {
+		value T
+		key   float64
+	}
+	// Each element gets the key u^(1/w), the k largest keys form the sample
+	reservoir := NewPriorityQueue(func(a, b keyed) bool { return a.key < b.key })
+	for v, w := range seq {
+		if w <= 0 || math.IsNaN(w) {
+			continue
+		}
+		key := math.Pow(randFloat64(rng), 1/w)
+		if reservoir.Len() < k {
+			reservoir.Push(keyed{v, key})
+		} else if lowest, _ := reservoir.Peek(); key > lowest.key {
+			reservoir.Pop()
+			reservoir.Push(keyed{v, key})
+		}
+	}
+	res := make([]T, 0, reservoir.Len())
+	for e := range reservoir.Drain() {
+		res = append(res, e.value)
+	}
+	return res
+}
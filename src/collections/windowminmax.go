@@ -0,0 +1,97 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+// WindowMinMax tracks the minimum and maximum of a sliding window in amortized O(1).
+// Elements are pushed at the back of the window and evicted from the front in the same order.
+type WindowMinMax[T cmp.Ordered] struct {
+	// mins and maxs are monotonic deques of the window elements that can still become the minimum or maximum
+	mins, maxs Deque[windowEntry[T]]
+	// pushed and evicted number the elements so that deque entries can be matched on eviction
+	pushed, evicted uint64
+}
+
+type windowEntry[T any] struct {
+	value T
+	seq   uint64
+}
+
+func NewWindowMinMax[T cmp.Ordered]() *WindowMinMax[T] {
+	return &WindowMinMax[T]{}
+}
+
+// Push adds value at the back of the window
+func (w *WindowMinMax[T]) Push(value T) {
+	e := windowEntry[T]{value, w.pushed}
+	w.pushed++
+	for b, ok := w.mins.Back(); ok && b.value > value; b, ok = w.mins.Back() {
+		w.mins.PopBack()
+	}
+	w.mins.PushBack(e)
+	for b, ok := w.maxs.Back(); ok && b.value < value; b, ok = w.maxs.Back() {
+		w.maxs.PopBack()
+	}
+	w.maxs.PushBack(e)
+}
+
+// Evict removes the oldest element of the window and reports false if the window is empty
+func (w *WindowMinMax[T]) Evict() bool {
+	if w.evicted == w.pushed {
+		return false
+	}
+	if f, _ := w.mins.Front(); f.seq == w.evicted {
+		w.mins.PopFront()
+	}
+	if f, _ := w.maxs.Front(); f.seq == w.evicted {
+		w.maxs.PopFront()
+	}
+	w.evicted++
+	return true
+}
+
+// Len returns the number of elements in the window
+func (w *WindowMinMax[T]) Len() int {
+	return int(w.pushed - w.evicted)
+}
+
+func (w *WindowMinMax[T]) Min() (T, bool) {
+	f, ok := w.mins.Front()
+	return f.value, ok
+}
+
+func (w *WindowMinMax[T]) Max() (T, bool) {
+	f, ok := w.maxs.Front()
+	return f.value, ok
+}
+
+// WindowedMin returns an iterator over the minimum of every window of size consecutive elements of seq
+func WindowedMin[T cmp.Ordered](seq iter.Seq[T], size int) iter.Seq[T] {
+	return windowed(seq, size, (*WindowMinMax[T]).Min)
+}
+
+// WindowedMax returns an iterator over the maximum of every window of size consecutive elements of seq
+func WindowedMax[T cmp.Ordered](seq iter.Seq[T], size int) iter.Seq[T] {
+	return windowed(seq, size, (*WindowMinMax[T]).Max)
+}
+
+func windowed[T cmp.Ordered](seq iter.Seq[T], size int, get func(*WindowMinMax[T]) (T, bool)) iter.Seq[T] {
+	size = max(size, 1)
+	return func(yield func(T) bool) {
+		w := NewWindowMinMax[T]()
+		for v := range seq {
+			w.Push(v)
+			if w.Len() > size {
+				w.Evict()
+			}
+			if w.Len() == size {
+				res, _ := get(w)
+				if !yield(res) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package collections
+
+import "sync/atomic"
+
+// WSDeque is a Chase-Lev work-stealing deque. The owning goroutine pushes and pops at the bottom,
+// any number of other goroutines may steal from the top.
+type WSDeque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	array  atomic.Pointer[wsRing[T]]
+}
+
+type wsRing[T any] struct {
+	slots []atomic.Pointer[T]
+}
+
+func (r *wsRing[T]) get(i int64) *T {
+	return r.slots[i&int64(len(r.slots)-1)].Load()
+}
+
+func (r *wsRing[T]) put(i int64, v *T) {
+	r.slots[i&int64(len(r.slots)-1)].Store(v)
+}
+
+// release clears slot i if it still holds v, so a taken value does not stay reachable. The compare
+// protects a value the owner may already have pushed into the same slot after the ring wrapped around.
+func (r *wsRing[T]) release(i int64, v *T) {
+	r.slots[i&int64(len(r.slots)-1)].CompareAndSwap(v, nil)
+}
+
+func (r *wsRing[T]) grow(bottom, top int64) *wsRing[T] {
+	n := &wsRing[T]{slots: make([]atomic.Pointer[T], 2*len(r.slots))}
+	for i := top; i < bottom; i++ {
+		n.put(i, r.get(i))
+	}
+	return n
+}
+
+func NewWSDeque[T any]() *WSDeque[T] {
+	d := &WSDeque[T]{}
+	d.array.Store(&wsRing[T]{slots: make([]atomic.Pointer[T], 32)})
+	return d
+}
+
+// PushBottom adds value at the bottom. Must only be called by the owner.
+func (d *WSDeque[T]) PushBottom(value T) {
+	b := d.bottom.Load()
+	t := d.top.Load()
+	a := d.array.Load()
+	if b-t >= int64(len(a.slots)) {
+		a = a.grow(b, t)
+		d.array.Store(a)
+	}
+	a.put(b, &value)
+	d.bottom.Store(b + 1)
+}
+
+// PopBottom removes the most recently pushed value. Must only be called by the owner.
+func (d *WSDeque[T]) PopBottom() (T, bool) {
+	var zero T
+	b := d.bottom.Load() - 1
+	a := d.array.Load()
+	d.bottom.Store(b)
+	t := d.top.Load()
+	if t > b {
+		// Deque was empty
+		d.bottom.Store(b + 1)
+		return zero, false
+	}
+	v := a.get(b)
+	if t == b {
+		// Last element, race against thieves for it
+		won := d.top.CompareAndSwap(t, t+1)
+		d.bottom.Store(b + 1)
+		if !won {
+			return zero, false
+		}
+	}
+	a.release(b, v)
+	return *v, true
+}
+
+// Steal removes the oldest value. It may be called from any goroutine and
+// reports false if the deque is empty or another goroutine won the race for the element.
+func (d *WSDeque[T]) Steal() (T, bool) {
+	var zero T
+	t := d.top.Load()
+	b := d.bottom.Load()
+	if t >= b {
+		return zero, false
+	}
+	a := d.array.Load()
+	v := a.get(t)
+	if !d.top.CompareAndSwap(t, t+1) {
+		return zero, false
+	}
+	// A ring grown concurrently may still hold a copy of v until the slot is reused
+	a.release(t, v)
+	return *v, true
+}
+
+func (d *WSDeque[T]) Len() int {
+	return int(max(d.bottom.Load()-d.top.Load(), 0))
+}
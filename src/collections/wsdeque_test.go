@@ -0,0 +1,79 @@
+package collections
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestWSDequeClearsTakenSlots(t *testing.T) {
+	d := NewWSDeque[int]()
+	for i := range 10 {
+		d.PushBottom(i)
+	}
+	for range 5 {
+		d.Steal()
+	}
+	for range 5 {
+		d.PopBottom()
+	}
+	for i := range d.array.Load().slots {
+		if v := d.array.Load().slots[i].Load(); v != nil {
+			t.Errorf("slot %d still holds %d", i, *v)
+		}
+	}
+}
+
+func TestWSDequeConcurrentSteal(t *testing.T) {
+	const n, thieves = 10_000, 4
+	d := NewWSDeque[int]()
+	seen := make([]int, n)
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		done = make(chan struct{})
+	)
+	take := func(v int) {
+		mu.Lock()
+		seen[v]++
+		mu.Unlock()
+	}
+	for range thieves {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if v, ok := d.Steal(); ok {
+					take(v)
+					continue
+				}
+				select {
+				case <-done:
+					return
+				default:
+				}
+			}
+		}()
+	}
+	for i := range n {
+		d.PushBottom(i)
+		if i%3 == 0 {
+			if v, ok := d.PopBottom(); ok {
+				take(v)
+			}
+		}
+	}
+	for {
+		v, ok := d.PopBottom()
+		if !ok {
+			break
+		}
+		take(v)
+	}
+	close(done)
+	wg.Wait()
+	for v, c := range seen {
+		if c != 1 {
+			t.Fatalf("value %d taken %d times", v, c)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package iterx
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"time"
+)
+
+// ErrNoContent is returned by Entry.Open for entries that were not read from an archive
+var ErrNoContent = errors.New("entry has no content")
+
+// Entry describes a file in an archive. Its content is only read when Open is called.
+type Entry struct {
+	Name    string
+	Size    int64
+	Mode    fs.FileMode
+	ModTime time.Time
+	open    func() (io.ReadCloser, error)
+}
+
+func (e Entry) IsDir() bool {
+	return e.Mode.IsDir()
+}
+
+// Open returns a reader over the content of the entry. Entries of a tar archive can only be read
+// before the iteration moves on to the next one. Entries not yielded by ZipEntries or TarEntries, such as
+// the zero Entry, fail with ErrNoContent.
+func (e Entry) Open() (io.ReadCloser, error) {
+	if e.open == nil {
+		return nil, fmt.Errorf("%w: %q", ErrNoContent, e.Name)
+	}
+	return e.open()
+}
+
+// ZipEntries yields the files of r in directory order. Their content can be opened at any time.
+func ZipEntries(r *zip.Reader) iter.Seq2[Entry, error] {
+	return func(yield func(Entry, error) bool) {
+		for _, f := range r.File {
+			e := Entry{
+				Name:    f.Name,
+				Size:    int64(f.UncompressedSize64),
+				Mode:    f.Mode(),
+				ModTime: f.Modified,
+				open:    f.Open,
+			}
+			if !yield(e, nil) {
+				return
+			}
+		}
+	}
+}
+
+// TarEntries yields the files of r in stream order. A read error is yielded once before the sequence stops.
+func TarEntries(r *tar.Reader) iter.Seq2[Entry, error] {
+	return func(yield func(Entry, error) bool) {
+		for {
+			h, err := r.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(Entry{}, err)
+				return
+			}
+			e := Entry{
+				Name:    h.Name,
+				Size:    h.Size,
+				Mode:    h.FileInfo().Mode(),
+				ModTime: h.ModTime,
+				open:    func() (io.ReadCloser, error) { return io.NopCloser(r), nil },
+			}
+			if !yield(e, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package iterx
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"iter"
+	"slices"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+var archiveFiles = []struct{ name, body string }{{"a.txt", "alpha"}, {"b.txt", "beta"}, {"c.txt", "gamma"}}
+
+func entryNames(seq iter.Seq2[Entry, error]) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for e, err := range seq {
+			name := e.Name
+			if err != nil {
+				name = err.Error()
+			}
+			if !yield(name) {
+				return
+			}
+		}
+	}
+}
+
+func TestZipEntries(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range archiveFiles {
+		w, _ := zw.Create(f.name)
+		io.WriteString(w, f.body)
+	}
+	zw.Close()
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq := ZipEntries(zr)
+	checkLaws(t, seqtest.Check(entryNames(seq), 4))
+	for e := range keys(seq) {
+		r, err := e.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(r)
+		r.Close()
+		if !slices.ContainsFunc(archiveFiles, func(f struct{ name, body string }) bool { return f.name == e.Name && f.body == string(body) }) {
+			t.Errorf("entry %s holds %q", e.Name, body)
+		}
+	}
+}
+
+func TestTarEntries(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range archiveFiles {
+		tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0o644, Size: int64(len(f.body))})
+		io.WriteString(tw, f.body)
+	}
+	tw.Close()
+	data := buf.Bytes()
+	seq := fresh2(func() iter.Seq2[Entry, error] { return TarEntries(tar.NewReader(bytes.NewReader(data))) })
+	checkLaws(t, seqtest.Check(entryNames(seq), 4))
+	i := 0
+	for e := range keys(seq) {
+		r, _ := e.Open()
+		if body, _ := io.ReadAll(r); string(body) != archiveFiles[i].body {
+			t.Errorf("entry %s holds %q", e.Name, body)
+		}
+		i++
+	}
+}
+
+func TestEntryOpenWithoutContent(t *testing.T) {
+	for _, e := range []Entry{{}, {Name: "made-up.txt", Size: 3}} {
+		if r, err := e.Open(); r != nil || !errors.Is(err, ErrNoContent) {
+			t.Errorf("Open of %q = %v, %v, want ErrNoContent", e.Name, r, err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// ToChan sends the elements of seq on the returned channel from a new goroutine.
+// The channel is closed when seq is exhausted or ctx is canceled.
+func ToChan[V any](ctx context.Context, seq iter.Seq[V], buf int) <-chan V {
+	ch := make(chan V, max(buf, 0))
+	go func() {
+		defer close(ch)
+		for v := range seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// FromChan returns an iterator over the values received from ch until it is closed
+func FromChan[V any](ch <-chan V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FanOut distributes the elements of seq round-robin across n channels.
+// All channels are closed when seq is exhausted or ctx is canceled.
+func FanOut[V any](ctx context.Context, seq iter.Seq[V], n int, buf int) []<-chan V {
+	n = max(n, 1)
+	return fanOut(ctx, seq, n, buf, func(i int, _ V) int { return i % n })
+}
+
+// FanOutBy sends every element of seq to the channel selected by the hash of its key,
+// so equal keys always end up on the same channel
+func FanOutBy[V any](ctx context.Context, seq iter.Seq[V], n int, buf int, hash func(V) uint64) []<-chan V {
+	n = max(n, 1)
+	return fanOut(ctx, seq, n, buf, func(_ int, v V) int { return int(hash(v) % uint64(n)) })
+}
+
+func fanOut[V any](ctx context.Context, seq iter.Seq[V], n int, buf int, pick func(int, V) int) []<-chan V {
+	chs := make([]chan V, n)
+	outs := make([]<-chan V, n)
+	for i := range chs {
+		chs[i] = make(chan V, max(buf, 0))
+		outs[i] = chs[i]
+	}
+	go func() {
+		defer func() {
+			for _, ch := range chs {
+				close(ch)
+			}
+		}()
+		i := 0
+		for v := range seq {
+			select {
+			case chs[pick(i, v)] <- v:
+			case <-ctx.Done():
+				return
+			}
+			i++
+		}
+	}()
+	return outs
+}
+
+// BatchChan groups the values received from ch into batches of up to size elements.
+// A batch is also yielded once flushEvery has passed since its first element arrived, bounding the latency for slow producers.
+func BatchChan[T any](ch <-chan T, size int, flushEvery time.Duration) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		size := max(size, 1)
+		timer := time.NewTimer(flushEvery)
+		timer.Stop()
+		defer timer.Stop()
+
+		var batch []T
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						yield(batch)
+					}
+					return
+				}
+				if len(batch) == 0 {
+					timer.Reset(flushEvery)
+				}
+				batch = append(batch, v)
+				if len(batch) < size {
+					continue
+				}
+				timer.Stop()
+			case <-timer.C:
+			}
+			if !yield(batch) {
+				return
+			}
+			batch = nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"testing"
+	"time"
+
+	"ROFT_examples/seqtest"
+)
+
+// drainFanOut reads the channels returned by FanOut one after the other and cancels the producer when the
+// consumer stops
+func drainFanOut(newChans func(ctx context.Context) []<-chan int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		chs := newChans(ctx)
+		// Merge in the order the values were distributed so the result is deterministic
+		for i := 0; ; i++ {
+			v, ok := <-chs[i%len(chs)]
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestToChan(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		for v := range ToChan(ctx, ints(5), 1) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	checkLaws(t, seqtest.Check(seq, 6))
+	if got := slices.Collect(iter.Seq[int](seq)); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("ToChan delivered %v", got)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	seq := fresh(func() iter.Seq[int] {
+		ch := make(chan int, 5)
+		for i := range 5 {
+			ch <- i
+		}
+		close(ch)
+		return FromChan(ch)
+	})
+	checkLaws(t, seqtest.Check(seq, 6))
+}
+
+func TestFanOut(t *testing.T) {
+	seq := drainFanOut(func(ctx context.Context) []<-chan int { return FanOut(ctx, ints(9), 3, 0) })
+	checkLaws(t, seqtest.Check(seq, 10))
+	if got := slices.Collect(seq); !slices.Equal(got, slices.Collect(ints(9))) {
+		t.Errorf("FanOut delivered %v", got)
+	}
+}
+
+func TestFanOutBy(t *testing.T) {
+	hash := func(v int) uint64 { return uint64(v) }
+	seq := drainFanOut(func(ctx context.Context) []<-chan int { return FanOutBy(ctx, ints(9), 3, 0, hash) })
+	checkLaws(t, seqtest.Check(seq, 10))
+	chs := FanOutBy(context.Background(), ints(9), 3, 9, hash)
+	for i, ch := range chs {
+		for v := range ch {
+			if int(hash(v)%3) != i {
+				t.Errorf("value %d sent to channel %d", v, i)
+			}
+		}
+	}
+}
+
+func TestBatchChan(t *testing.T) {
+	seq := fresh(func() iter.Seq[[]int] {
+		ch := make(chan int, 7)
+		for i := range 7 {
+			ch <- i
+		}
+		close(ch)
+		return BatchChan(ch, 3, time.Hour)
+	})
+	checkLaws(t, seqtest.Check(seq, 4))
+	if got := slices.Collect(seq); len(got) != 3 || !slices.Equal(got[2], []int{6}) {
+		t.Errorf("BatchChan yielded %v", got)
+	}
+}
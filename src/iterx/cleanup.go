@@ -0,0 +1,48 @@
+package iterx
+
+import (
+	"iter"
+	"sync"
+)
+
+// Sequences backed by a resource (file, connection, rows, ...) release it as soon as an iteration ends,
+// whether the source was exhausted or the consumer stopped early. The adapters of this package acquire
+// their resources per iteration and release them with a defer in the same iteration: the Pull-based ones
+// (Pairwise, Zip, MergeSorted) call the stop function of iter.Pull, and the goroutine-based ones (FanIn,
+// FanOut, Prefetch, ParallelMap) signal their goroutines to exit. WithCleanup is meant for resources
+// that the caller opened for a sequence, such as the file under Lines.
+//
+// SeqCloser is implemented by such sources that can also be released without ever being iterated,
+// such as the file opened by OpenLines.
+type SeqCloser[V any] interface {
+	All() iter.Seq2[V, error]
+	Close() error
+}
+
+// WithCleanup returns an iterator that calls cleanup once the first iteration over it ends,
+// including when the consumer stops early or panics. If the iterator is never ranged over, cleanup
+// never runs; hand out a SeqCloser instead when that can happen.
+func WithCleanup[V any](seq iter.Seq[V], cleanup func()) iter.Seq[V] {
+	var once sync.Once
+	return func(yield func(V) bool) {
+		defer once.Do(cleanup)
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// WithCleanup2 is the iter.Seq2 version of WithCleanup
+func WithCleanup2[K, V any](seq iter.Seq2[K, V], cleanup func()) iter.Seq2[K, V] {
+	var once sync.Once
+	return func(yield func(K, V) bool) {
+		defer once.Do(cleanup)
+		for k, v := range seq {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package iterx
+
+import (
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestWithCleanup(t *testing.T) {
+	checkLaws(t, seqtest.Check(WithCleanup(ints(5), func() {}), 6))
+	checkLaws(t, seqtest.Check2(WithCleanup2(Pairwise(ints(5)), func() {}), 5))
+
+	calls := 0
+	seq := WithCleanup(ints(5), func() { calls++ })
+	for range seq {
+		break
+	}
+	for range seq {
+	}
+	if calls != 1 {
+		t.Errorf("cleanup ran %d times, want 1", calls)
+	}
+}
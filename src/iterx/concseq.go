@@ -0,0 +1,208 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// Prefetch runs seq in its own goroutine and buffers up to n elements ahead of the consumer.
+// The goroutine is stopped and waited for when the consumer stops early.
+func Prefetch[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		ch := make(chan V, max(n, 0))
+		done := make(chan struct{})
+		var panicked any
+		go func() {
+			defer close(ch)
+			defer func() { panicked = recover() }()
+			for v := range seq {
+				select {
+				case ch <- v:
+				case <-done:
+					return
+				}
+			}
+		}()
+		defer func() {
+			close(done)
+			// Wait for the producer to finish
+			for range ch {
+			}
+			if panicked != nil {
+				panic(panicked)
+			}
+		}()
+
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ParallelMap applies f to the elements of seq on up to workers goroutines and yields the results in input order
+func ParallelMap[V, U any](seq iter.Seq[V], workers int, f func(V) U) iter.Seq[U] {
+	type result struct {
+		u        U
+		panicked any
+	}
+	type job struct {
+		v   V
+		res chan result
+	}
+	return func(yield func(U) bool) {
+		workers := max(workers, 1)
+		jobs := make(chan job)
+		// order holds the pending results in input order and bounds the work in flight
+		order := make(chan chan result, workers)
+		done := make(chan struct{})
+
+		var wg sync.WaitGroup
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range jobs {
+					func() {
+						var r result
+						defer func() {
+							r.panicked = recover()
+							j.res <- r
+						}()
+						r.u = f(j.v)
+					}()
+				}
+			}()
+		}
+
+		var panicked any
+		go func() {
+			defer close(order)
+			defer close(jobs)
+			defer func() { panicked = recover() }()
+			for v := range seq {
+				res := make(chan result, 1)
+				select {
+				case order <- res:
+				case <-done:
+					return
+				}
+				select {
+				case jobs <- job{v, res}:
+				case <-done:
+					return
+				}
+			}
+		}()
+		defer func() {
+			close(done)
+			for range order {
+			}
+			wg.Wait()
+			if panicked != nil {
+				panic(panicked)
+			}
+		}()
+
+		for res := range order {
+			r := <-res
+			if r.panicked != nil {
+				panic(r.panicked)
+			}
+			if !yield(r.u) {
+				return
+			}
+		}
+	}
+}
+
+// ParallelForEach calls f for every element of seq on up to workers goroutines.
+// The first error or the cancellation of ctx stops feeding new elements; all errors are joined and returned.
+func ParallelForEach[V any](ctx context.Context, seq iter.Seq[V], workers int, f func(V) error) error {
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	jobs := make(chan V)
+	for range max(workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range jobs {
+				if err := f(v); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+
+feed:
+	for v := range seq {
+		select {
+		case jobs <- v:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// FanIn consumes all srcs concurrently and yields their elements in arrival order.
+// When the consumer stops or ctx is canceled, all source goroutines are stopped and waited for.
+func FanIn[V any](ctx context.Context, srcs ...iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		ch := make(chan V)
+		var wg sync.WaitGroup
+		for _, src := range srcs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for v := range src {
+					select {
+					case ch <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(ch)
+		}()
+		defer func() {
+			cancel()
+			for range ch {
+			}
+		}()
+
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok || !yield(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
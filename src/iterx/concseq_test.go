@@ -0,0 +1,62 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestPrefetch(t *testing.T) {
+	checkLaws(t, seqtest.Check(Prefetch(ints(6), 2), 7))
+}
+
+func TestParallelMap(t *testing.T) {
+	seq := ParallelMap(ints(6), 3, strconv.Itoa)
+	checkLaws(t, seqtest.Check(seq, 7))
+	if got := slices.Collect(seq); !slices.Equal(got, []string{"0", "1", "2", "3", "4", "5"}) {
+		t.Errorf("ParallelMap yielded %v", got)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := seqtest.NoLeak(func() {
+		if err := ParallelForEach(context.Background(), ints(10), 3, func(v int) error {
+			sum.Add(int64(v))
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+	})
+	checkLaws(t, err)
+	if sum.Load() != 45 {
+		t.Errorf("sum = %d, want 45", sum.Load())
+	}
+
+	errBoom := errors.New("boom")
+	checkLaws(t, seqtest.NoLeak(func() {
+		err := ParallelForEach(context.Background(), ints(100), 3, func(v int) error {
+			if v == 5 {
+				return errBoom
+			}
+			return nil
+		})
+		if !errors.Is(err, errBoom) {
+			t.Errorf("ParallelForEach returned %v, want %v", err, errBoom)
+		}
+	}))
+}
+
+func TestFanIn(t *testing.T) {
+	seq := FanIn(context.Background(), ints(3), ints(4))
+	// Arrival order varies between runs, so only the count is checked besides the streaming laws
+	checkLaws(t, checkStream(seq, 8))
+	if got := slices.Sorted(seq); !slices.Equal(got, []int{0, 0, 1, 1, 2, 2, 3}) {
+		t.Errorf("FanIn yielded %v", got)
+	}
+}
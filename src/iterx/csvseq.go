@@ -0,0 +1,84 @@
+package iterx
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"iter"
+)
+
+// CSVOption configures the csv.Reader used by CSVRecords and CSVRows
+type CSVOption func(*csv.Reader)
+
+// CSVComma sets the field delimiter
+func CSVComma(r rune) CSVOption {
+	return func(cr *csv.Reader) { cr.Comma = r }
+}
+
+// CSVComment makes lines starting with r be skipped
+func CSVComment(r rune) CSVOption {
+	return func(cr *csv.Reader) { cr.Comment = r }
+}
+
+// CSVLazyQuotes allows quotes in unquoted fields and unescaped quotes in quoted fields
+func CSVLazyQuotes() CSVOption {
+	return func(cr *csv.Reader) { cr.LazyQuotes = true }
+}
+
+// CSVFieldsPerRecord sets the expected number of fields. Negative disables the check and zero takes
+// the count of the first record.
+func CSVFieldsPerRecord(n int) CSVOption {
+	return func(cr *csv.Reader) { cr.FieldsPerRecord = n }
+}
+
+// CSVRecords yields the records of r. Malformed records are yielded as errors and reading continues,
+// while any other read error is yielded once before the sequence stops.
+func CSVRecords(r io.Reader, opts ...CSVOption) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		cr := csv.NewReader(r)
+		for _, opt := range opts {
+			opt(cr)
+		}
+		for {
+			record, err := cr.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(record, err) {
+				return
+			}
+			var perr *csv.ParseError
+			if err != nil && !errors.As(err, &perr) {
+				return
+			}
+		}
+	}
+}
+
+// CSVRows treats the first record of r as the header and yields every following record keyed by column name
+func CSVRows(r io.Reader, opts ...CSVOption) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		var header []string
+		for record, err := range CSVRecords(r, opts...) {
+			if err != nil {
+				if !yield(nil, err) {
+					return
+				}
+				continue
+			}
+			if header == nil {
+				header = record
+				continue
+			}
+			row := make(map[string]string, len(header))
+			for i, name := range header {
+				if i < len(record) {
+					row[name] = record[i]
+				}
+			}
+			if !yield(row, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package iterx
+
+import (
+	"iter"
+	"strings"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+const sampleCSV = "name;age\n# comment\nann;31\nbob;x;extra\ncid;27\n"
+
+func TestCSVRecords(t *testing.T) {
+	seq := fresh2(func() iter.Seq2[[]string, error] {
+		return CSVRecords(strings.NewReader(sampleCSV), CSVComma(';'), CSVComment('#'), CSVFieldsPerRecord(2))
+	})
+	checkLaws(t, seqtest.Check2(seq, 5))
+	var records, errs int
+	for _, err := range seq {
+		if err != nil {
+			errs++
+		} else {
+			records++
+		}
+	}
+	if records != 3 || errs != 1 {
+		t.Errorf("CSVRecords yielded %d records and %d errors, want 3 and 1", records, errs)
+	}
+}
+
+func TestCSVRows(t *testing.T) {
+	seq := fresh2(func() iter.Seq2[map[string]string, error] {
+		return CSVRows(strings.NewReader(sampleCSV), CSVComma(';'), CSVComment('#'), CSVLazyQuotes())
+	})
+	checkLaws(t, seqtest.Check2(seq, 5))
+	for row, err := range seq {
+		if err == nil && row["name"] == "cid" && row["age"] != "27" {
+			t.Errorf("CSVRows yielded %v", row)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// WithContext returns an iterator that stops yielding once ctx is canceled
+func WithContext[V any](ctx context.Context, seq iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if ctx.Err() != nil {
+			return
+		}
+		for v := range seq {
+			if ctx.Err() != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// WithTimeout returns an iterator that stops yielding once d has elapsed since iteration started
+func WithTimeout[V any](seq iter.Seq[V], d time.Duration) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		deadline := time.Now().Add(d)
+		for v := range seq {
+			if !time.Now().Before(deadline) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Limiter is satisfied by golang.org/x/time/rate.Limiter
+type Limiter interface {
+	Wait(context.Context) error
+}
+
+// RateLimit waits on limiter before yielding each element and stops if waiting fails
+func RateLimit[V any](seq iter.Seq[V], limiter Limiter) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			if limiter.Wait(context.Background()) != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"ROFT_examples/seqtest"
+)
+
+type countingLimiter struct {
+	waits, failAfter int
+}
+
+func (l *countingLimiter) Wait(context.Context) error {
+	if l.waits++; l.failAfter > 0 && l.waits > l.failAfter {
+		return errors.New("limit exceeded")
+	}
+	return nil
+}
+
+func TestWithContext(t *testing.T) {
+	checkLaws(t, seqtest.Check(WithContext(context.Background(), ints(5)), 6))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := slices.Collect(WithContext(ctx, ints(5))); len(got) != 0 {
+		t.Errorf("canceled WithContext yielded %v", got)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	checkLaws(t, seqtest.Check(WithTimeout(ints(5), time.Hour), 6))
+	if got := slices.Collect(WithTimeout(ints(5), 0)); len(got) != 0 {
+		t.Errorf("expired WithTimeout yielded %v", got)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	checkLaws(t, seqtest.Check(RateLimit(ints(5), &countingLimiter{}), 6))
+	if got := slices.Collect(RateLimit(ints(5), &countingLimiter{failAfter: 2})); !slices.Equal(got, []int{0, 1}) {
+		t.Errorf("RateLimit yielded %v after the limiter failed", got)
+	}
+}
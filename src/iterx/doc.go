@@ -0,0 +1,2 @@
+// Package iterx provides adapters, sources and sinks for iter.Seq and iter.Seq2.
+package iterx
@@ -0,0 +1,109 @@
+package iterx
+
+import (
+	"errors"
+	"iter"
+
+	"ROFT_examples/collections"
+)
+
+// TryMap applies a fallible function to every element of seq and yields each result together with its error
+func TryMap[V, U any](seq iter.Seq[V], f func(V) (U, error)) iter.Seq2[U, error] {
+	return func(yield func(U, error) bool) {
+		for v := range seq {
+			u, err := f(v)
+			if !yield(u, err) {
+				return
+			}
+		}
+	}
+}
+
+// StopOnError passes elements through until the first error, which is yielded before the sequence stops
+func StopOnError[V any](seq iter.Seq2[V, error]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for v, err := range seq {
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// CollectUntilError collects values into a slice until the first error is encountered
+func CollectUntilError[V any](seq iter.Seq2[V, error]) ([]V, error) {
+	var s []V
+	for v, err := range seq {
+		if err != nil {
+			return s, err
+		}
+		s = append(s, v)
+	}
+	return s, nil
+}
+
+// FilterOk yields only the values of elements without an error
+func FilterOk[V any](seq iter.Seq2[V, error]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v, err := range seq {
+			if err != nil {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Errors yields only the non-nil errors of a fallible sequence
+func Errors[V any](seq iter.Seq2[V, error]) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		for _, err := range seq {
+			if err == nil {
+				continue
+			}
+			if !yield(err) {
+				return
+			}
+		}
+	}
+}
+
+// PartitionErrors consumes the whole sequence, collecting all successful values and joining all errors
+func PartitionErrors[V any](seq iter.Seq2[V, error]) ([]V, error) {
+	var (
+		s    []V
+		errs []error
+	)
+	for v, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		s = append(s, v)
+	}
+	return s, errors.Join(errs...)
+}
+
+// ToResults converts a fallible sequence into a sequence of Results
+func ToResults[V any](seq iter.Seq2[V, error]) iter.Seq[collections.Result[V]] {
+	return func(yield func(collections.Result[V]) bool) {
+		for v, err := range seq {
+			if !yield(collections.ResultOf(v, err)) {
+				return
+			}
+		}
+	}
+}
+
+// FromResults converts a sequence of Results back into a fallible sequence
+func FromResults[V any](seq iter.Seq[collections.Result[V]]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for r := range seq {
+			if !yield(r.Get()) {
+				return
+			}
+		}
+	}
+}
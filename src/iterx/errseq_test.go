@@ -0,0 +1,56 @@
+package iterx
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func atoiSeq() iter.Seq2[int, error] {
+	return TryMap(slices.Values([]string{"1", "x", "3", "y"}), strconv.Atoi)
+}
+
+func TestTryMap(t *testing.T) {
+	checkLaws(t, seqtest.Check2(atoiSeq(), 5))
+	checkLaws(t, seqtest.Check2(StopOnError(atoiSeq()), 5))
+	checkLaws(t, seqtest.Check(FilterOk(atoiSeq()), 5))
+	checkLaws(t, seqtest.Check(Errors(atoiSeq()), 5))
+	if got := slices.Collect(FilterOk(atoiSeq())); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("FilterOk yielded %v", got)
+	}
+	if n := len(slices.Collect(keys(StopOnError(atoiSeq())))); n != 2 {
+		t.Errorf("StopOnError yielded %d elements, want 2", n)
+	}
+}
+
+func TestCollectUntilError(t *testing.T) {
+	var (
+		got []int
+		err error
+	)
+	// Running the source through Prefetch makes stopping at the first error shut down a producer goroutine
+	prefetched := FromResults(Prefetch(ToResults(atoiSeq()), 1))
+	checkLaws(t, seqtest.NoLeak(func() { got, err = CollectUntilError(prefetched) }))
+	if err == nil || !slices.Equal(got, []int{1}) {
+		t.Errorf("CollectUntilError = %v, %v", got, err)
+	}
+	got, err = PartitionErrors(atoiSeq())
+	if err == nil || !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("PartitionErrors = %v, %v", got, err)
+	}
+}
+
+func TestResults(t *testing.T) {
+	checkLaws(t, seqtest.Check(ToResults(atoiSeq()), 5))
+	checkLaws(t, seqtest.Check2(FromResults(ToResults(atoiSeq())), 5))
+	var oks []bool
+	for r := range ToResults(atoiSeq()) {
+		oks = append(oks, r.IsOk())
+	}
+	if !slices.Equal(oks, []bool{true, false, true, false}) {
+		t.Errorf("ToResults produced %v", oks)
+	}
+}
@@ -0,0 +1,34 @@
+package iterx
+
+import (
+	"encoding/gob"
+	"errors"
+	"io"
+	"iter"
+)
+
+// EncodeSeq encodes every element of seq as a separate gob value, so the receiver can decode them one at a time
+func EncodeSeq[T any](enc *gob.Encoder, seq iter.Seq[T]) error {
+	for v := range seq {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeSeq decodes gob values until the end of the stream. A decoding error is yielded once before the sequence stops.
+func DecodeSeq[T any](dec *gob.Decoder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			var v T
+			err := dec.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
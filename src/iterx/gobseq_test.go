@@ -0,0 +1,24 @@
+package iterx
+
+import (
+	"bytes"
+	"encoding/gob"
+	"iter"
+	"slices"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestDecodeSeq(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeSeq(gob.NewEncoder(&buf), ints(4)); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	seq := fresh2(func() iter.Seq2[int, error] { return DecodeSeq[int](gob.NewDecoder(bytes.NewReader(data))) })
+	checkLaws(t, seqtest.Check2(seq, 5))
+	if got := slices.Collect(keys(seq)); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("DecodeSeq yielded %v", got)
+	}
+}
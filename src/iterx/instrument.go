@@ -0,0 +1,112 @@
+package iterx
+
+import (
+	"expvar"
+	"iter"
+	"log/slog"
+	"time"
+
+	"ROFT_examples/collections"
+)
+
+// Hooks observe an instrumented sequence. Every field is optional.
+type Hooks struct {
+	// OnStart is called when an iteration begins
+	OnStart func()
+	// OnElement is called for every element before it is passed on, with its zero-based index
+	OnElement func(index int, v any)
+	// OnDone is called when an iteration ends, including after an early break or a panic
+	OnDone func(IterStats)
+}
+
+// IterStats describes one finished iteration of an instrumented sequence
+type IterStats struct {
+	// Count is the number of elements yielded
+	Count int
+	// Duration is the wall time from the start to the end of the iteration, including the time spent by the consumer
+	Duration time.Duration
+	// Stopped reports whether the consumer broke out of the loop early
+	Stopped bool
+}
+
+// Instrument passes the elements of seq through unchanged and reports them to hooks
+func Instrument[V any](seq iter.Seq[V], hooks Hooks) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		var stats IterStats
+		defer hooks.begin()(&stats)
+		for v := range seq {
+			if hooks.OnElement != nil {
+				hooks.OnElement(stats.Count, v)
+			}
+			stats.Count++
+			if !yield(v) {
+				stats.Stopped = true
+				return
+			}
+		}
+	}
+}
+
+// Instrument2 is Instrument for iter.Seq2. OnElement receives each pair as a collections.Pair.
+func Instrument2[K, V any](seq iter.Seq2[K, V], hooks Hooks) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stats IterStats
+		defer hooks.begin()(&stats)
+		for k, v := range seq {
+			if hooks.OnElement != nil {
+				hooks.OnElement(stats.Count, collections.Pair[K, V]{Key: k, Value: v})
+			}
+			stats.Count++
+			if !yield(k, v) {
+				stats.Stopped = true
+				return
+			}
+		}
+	}
+}
+
+// begin calls OnStart and returns the function that completes stats and calls OnDone
+func (h Hooks) begin() func(*IterStats) {
+	if h.OnStart != nil {
+		h.OnStart()
+	}
+	start := time.Now()
+	return func(stats *IterStats) {
+		stats.Duration = time.Since(start)
+		if h.OnDone != nil {
+			h.OnDone(*stats)
+		}
+	}
+}
+
+// LogHooks logs the end of every iteration at info level, and every element at debug level
+func LogHooks(logger *slog.Logger, name string) Hooks {
+	return Hooks{
+		OnElement: func(index int, v any) {
+			logger.Debug("element", "seq", name, "index", index, "value", v)
+		},
+		OnDone: func(s IterStats) {
+			logger.Info("iteration done", "seq", name, "count", s.Count, "duration", s.Duration, "stopped", s.Stopped)
+		},
+	}
+}
+
+// ExpvarHooks accumulates the number of iterations, elements, early stops and the total duration in
+// nanoseconds in the expvar map published under name, creating it if needed. Like expvar.NewMap, it panics
+// if name is already published as a different kind of variable.
+func ExpvarHooks(name string) Hooks {
+	m, ok := expvar.Get(name).(*expvar.Map)
+	if !ok {
+		m = expvar.NewMap(name)
+	}
+	return Hooks{
+		OnDone: func(s IterStats) {
+			m.Add("iterations", 1)
+			m.Add("elements", int64(s.Count))
+			m.Add("nanoseconds", int64(s.Duration))
+			if s.Stopped {
+				m.Add("stopped", 1)
+			}
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package iterx
+
+import (
+	"bytes"
+	"expvar"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestInstrument(t *testing.T) {
+	var last IterStats
+	hooks := Hooks{OnElement: func(int, any) {}, OnDone: func(s IterStats) { last = s }}
+	checkLaws(t, seqtest.Check(Instrument(ints(5), hooks), 6))
+	checkLaws(t, seqtest.Check2(Instrument2(Pairwise(ints(5)), hooks), 5))
+
+	var starts, elements int
+	hooks = Hooks{
+		OnStart:   func() { starts++ },
+		OnElement: func(int, any) { elements++ },
+		OnDone:    func(s IterStats) { last = s },
+	}
+	for v := range Instrument(ints(5), hooks) {
+		if v == 2 {
+			break
+		}
+	}
+	if starts != 1 || elements != 3 || last.Count != 3 || !last.Stopped {
+		t.Errorf("starts %d, elements %d, stats %+v", starts, elements, last)
+	}
+	for range Instrument(ints(5), hooks) {
+	}
+	if last.Count != 5 || last.Stopped {
+		t.Errorf("stats after a full iteration: %+v", last)
+	}
+}
+
+func TestLogHooks(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	checkLaws(t, seqtest.Check(Instrument(ints(3), LogHooks(logger, "ints")), 4))
+	if !strings.Contains(buf.String(), "seq=ints index=2 value=2") {
+		t.Errorf("log lacks the last element:\n%s", buf.String())
+	}
+}
+
+func TestExpvarHooks(t *testing.T) {
+	seq := Instrument(ints(3), ExpvarHooks("iterx_test"))
+	m := expvar.Get("iterx_test").(*expvar.Map)
+	// The map is global, so compare the increments when the test runs repeatedly
+	counter := func(key string) int64 {
+		if v, ok := m.Get(key).(*expvar.Int); ok {
+			return v.Value()
+		}
+		return 0
+	}
+	want := map[string]int64{"iterations": 2, "elements": 4, "stopped": 1}
+	before := make(map[string]int64, len(want))
+	for key := range want {
+		before[key] = counter(key)
+	}
+	for range seq {
+	}
+	for range seq {
+		break
+	}
+	for key, n := range want {
+		if got := counter(key) - before[key]; got != n {
+			t.Errorf("%s grew by %d, want %d", key, got, n)
+		}
+	}
+}
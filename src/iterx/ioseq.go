@@ -0,0 +1,119 @@
+package iterx
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"iter"
+	"math"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Lines yields the lines of r without their line endings. Lines of any length are supported, and a read
+// error is yielded once before the sequence stops.
+func Lines(r io.Reader) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if len(line) > 0 {
+				line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+				if !yield(line, nil) {
+					return
+				}
+			}
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield("", err)
+				}
+				return
+			}
+		}
+	}
+}
+
+// Tokens yields the tokens of r produced by split, such as bufio.ScanWords. Tokens are not limited to the
+// default bufio.Scanner size, and a read error is yielded once before the sequence stops.
+func Tokens(r io.Reader, split bufio.SplitFunc) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		sc := bufio.NewScanner(r)
+		sc.Buffer(nil, math.MaxInt)
+		sc.Split(split)
+		for sc.Scan() {
+			if !yield(sc.Text(), nil) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
+
+// Words yields the space-separated words of r
+func Words(r io.Reader) iter.Seq2[string, error] {
+	return Tokens(r, bufio.ScanWords)
+}
+
+// Runes yields the UTF-8 characters of r one at a time, with invalid bytes replaced by U+FFFD
+func Runes(r io.Reader) iter.Seq2[string, error] {
+	return Tokens(r, bufio.ScanRunes)
+}
+
+// GzipLines yields the lines of r like Lines, decompressing it first if it starts with the gzip magic bytes
+func GzipLines(r io.Reader) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		br := bufio.NewReader(r)
+		magic, err := br.Peek(2)
+		if err != nil && !errors.Is(err, io.EOF) {
+			yield("", err)
+			return
+		}
+		var src io.Reader = br
+		if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+			zr, err := gzip.NewReader(br)
+			if err != nil {
+				yield("", err)
+				return
+			}
+			defer zr.Close()
+			src = zr
+		}
+		for line, err := range Lines(src) {
+			if !yield(line, err) {
+				return
+			}
+		}
+	}
+}
+
+// OpenLines opens the file at path and returns its lines like GzipLines. The file is closed when the first
+// iteration over All ends or by Close, whichever comes first, so the lines can be ranged over once.
+func OpenLines(path string) (SeqCloser[string], error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLines{f: f}, nil
+}
+
+// fileLines is the SeqCloser returned by OpenLines
+type fileLines struct {
+	f    *os.File
+	once sync.Once
+	err  error
+}
+
+func (l *fileLines) All() iter.Seq2[string, error] {
+	return WithCleanup2(GzipLines(l.f), func() { l.Close() })
+}
+
+// Close closes the file. Only the first call has an effect and later calls return its error.
+func (l *fileLines) Close() error {
+	l.once.Do(func() { l.err = l.f.Close() })
+	return l.err
+}
@@ -0,0 +1,104 @@
+package iterx
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+const sampleText = "alpha beta\ngamma\n\ndelta epsilon zeta"
+
+// readerSeq recreates the reader for every iteration, so that read can be checked like a pure sequence
+func readerSeq(data string, read func(io.Reader) iter.Seq2[string, error]) iter.Seq2[string, error] {
+	return fresh2(func() iter.Seq2[string, error] { return read(strings.NewReader(data)) })
+}
+
+func TestLines(t *testing.T) {
+	seq := readerSeq(sampleText, Lines)
+	checkLaws(t, seqtest.Check2(seq, 5))
+	if got := slices.Collect(keys(seq)); !slices.Equal(got, []string{"alpha beta", "gamma", "", "delta epsilon zeta"}) {
+		t.Errorf("Lines yielded %q", got)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	checkLaws(t, seqtest.Check2(readerSeq(sampleText, Words), 7))
+	checkLaws(t, seqtest.Check2(readerSeq("héllo", Runes), 6))
+	lines := func(r io.Reader) iter.Seq2[string, error] { return Tokens(r, bufio.ScanLines) }
+	checkLaws(t, seqtest.Check2(readerSeq(sampleText, lines), 5))
+	if got := slices.Collect(keys(readerSeq(sampleText, Words))); len(got) != 6 {
+		t.Errorf("Words yielded %q", got)
+	}
+}
+
+func TestGzipLines(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	io.WriteString(zw, sampleText)
+	zw.Close()
+	for name, data := range map[string]string{"plain": sampleText, "gzip": buf.String()} {
+		seq := readerSeq(data, GzipLines)
+		checkLaws(t, seqtest.Check2(seq, 5))
+		if got := slices.Collect(keys(seq)); len(got) != 4 || got[1] != "gamma" {
+			t.Errorf("GzipLines on %s input yielded %q", name, got)
+		}
+	}
+}
+
+func TestOpenLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sample.txt")
+	if err := os.WriteFile(path, []byte(sampleText), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	isClosed := func(lines SeqCloser[string]) bool {
+		_, err := lines.(*fileLines).f.Stat()
+		return errors.Is(err, os.ErrClosed)
+	}
+
+	// Never iterated: only Close releases the file
+	lines, err := OpenLines(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isClosed(lines) {
+		t.Fatal("file closed before iterating or calling Close")
+	}
+	if err := errors.Join(lines.Close(), lines.Close()); err != nil {
+		t.Errorf("Close = %v", err)
+	}
+	if !isClosed(lines) {
+		t.Error("Close did not close the never-iterated file")
+	}
+
+	// Stopped early: the iteration closes the file and a later Close is a no-op
+	lines, err = OpenLines(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for line, err := range lines.All() {
+		if line != "alpha beta" || err != nil {
+			t.Errorf("first line = %q, %v", line, err)
+		}
+		break
+	}
+	if !isClosed(lines) {
+		t.Error("stopping the iteration did not close the file")
+	}
+	if err := lines.Close(); err != nil {
+		t.Errorf("Close after iterating = %v", err)
+	}
+
+	if _, err := OpenLines(filepath.Join(t.TempDir(), "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("OpenLines of a missing file = %v, want ErrNotExist", err)
+	}
+}
@@ -0,0 +1,58 @@
+package iterx
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+// ints returns a pure sequence over 0..n-1
+func ints(n int) iter.Seq[int] {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return slices.Values(s)
+}
+
+// fresh turns a sequence over a single-use source into a pure one by recreating the source on every iteration
+func fresh[V any](newSeq func() iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		newSeq()(yield)
+	}
+}
+
+// fresh2 is fresh for iter.Seq2
+func fresh2[K, V any](newSeq func() iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		newSeq()(yield)
+	}
+}
+
+// keys drops the second value of every pair, for sequences whose second values cannot be compared
+func keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// checkStream runs the laws that apply to sequences whose elements differ between runs, such as
+// timestamps or arrival orders
+func checkStream[V any](seq iter.Seq[V], limit int) error {
+	return errors.Join(seqtest.EarlyStop(seq, limit), seqtest.NoLeakOnBreak(seq, limit))
+}
+
+// checkLaws reports a failed iterator law check as a test error
+func checkLaws(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Error(err)
+	}
+}
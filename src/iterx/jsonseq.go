@@ -0,0 +1,82 @@
+package iterx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+var ErrNotJSONArray = errors.New("input is not a JSON array")
+
+// DecodeJSONArray decodes the elements of a top-level JSON array one at a time, so only a single element
+// is held in memory. Decoding stops at the first error, which is yielded.
+func DecodeJSONArray[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		dec := json.NewDecoder(r)
+		tok, err := dec.Token()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			yield(zero, fmt.Errorf("%w: starts with %v", ErrNotJSONArray, tok))
+			return
+		}
+		for dec.More() {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// WriteJSONL writes every element of seq as one JSON line and stops at the first error
+func WriteJSONL[T any](w io.Writer, seq iter.Seq[T]) error {
+	enc := json.NewEncoder(w)
+	for v := range seq {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadJSONL decodes one value per non-empty line of r. A line that fails to decode yields an error and
+// reading continues with the next line.
+func ReadJSONL[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		n := 0
+		for line, err := range Lines(r) {
+			var v T
+			n++
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(line), &v); err != nil {
+				if !yield(v, fmt.Errorf("line %d: %w", n, err)) {
+					return
+				}
+				continue
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
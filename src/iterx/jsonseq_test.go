@@ -0,0 +1,47 @@
+package iterx
+
+import (
+	"bytes"
+	"errors"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestDecodeJSONArray(t *testing.T) {
+	seq := fresh2(func() iter.Seq2[int, error] { return DecodeJSONArray[int](strings.NewReader("[1, 2, 3]")) })
+	checkLaws(t, seqtest.Check2(seq, 4))
+	if got := slices.Collect(keys(seq)); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("DecodeJSONArray yielded %v", got)
+	}
+	for _, err := range DecodeJSONArray[int](strings.NewReader(`{"a": 1}`)) {
+		if !errors.Is(err, ErrNotJSONArray) {
+			t.Errorf("decoding an object: %v, want %v", err, ErrNotJSONArray)
+		}
+	}
+}
+
+func TestJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSONL(&buf, ints(3)); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.String() + "oops\n3\n"
+	seq := fresh2(func() iter.Seq2[int, error] { return ReadJSONL[int](strings.NewReader(data)) })
+	checkLaws(t, seqtest.Check2(seq, 6))
+	var got []int
+	var errs int
+	for v, err := range seq {
+		if err != nil {
+			errs++
+			continue
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{0, 1, 2, 3}) || errs != 1 {
+		t.Errorf("ReadJSONL yielded %v with %d errors", got, errs)
+	}
+}
@@ -0,0 +1,48 @@
+package iterx
+
+import (
+	"cmp"
+	"iter"
+)
+
+// MergeSorted merges sequences that are each sorted in ascending order into one sorted sequence
+func MergeSorted[V cmp.Ordered](seqs ...iter.Seq[V]) iter.Seq[V] {
+	return MergeSortedFunc(cmp.Compare[V], seqs...)
+}
+
+// MergeSortedFunc merges sequences that are each sorted by cmp into one sequence sorted by cmp.
+// Equal elements are yielded in the order of the sequences they came from.
+func MergeSortedFunc[V any](cmp func(a, b V) int, seqs ...iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		type source struct {
+			next func() (V, bool)
+			head V
+		}
+		sources := make([]source, 0, len(seqs))
+		for _, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			if v, ok := next(); ok {
+				sources = append(sources, source{next, v})
+			}
+		}
+
+		for len(sources) > 0 {
+			// Find the source with the smallest head
+			m := 0
+			for i := 1; i < len(sources); i++ {
+				if cmp(sources[i].head, sources[m].head) < 0 {
+					m = i
+				}
+			}
+			if !yield(sources[m].head) {
+				return
+			}
+			if v, ok := sources[m].next(); ok {
+				sources[m].head = v
+			} else {
+				sources = append(sources[:m], sources[m+1:]...)
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package iterx
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestMergeSorted(t *testing.T) {
+	seq := MergeSorted(slices.Values([]int{1, 4, 7}), slices.Values([]int{2, 3, 8, 9}), ints(0))
+	checkLaws(t, seqtest.Check(seq, 8))
+	if got := slices.Collect(seq); !slices.Equal(got, []int{1, 2, 3, 4, 7, 8, 9}) {
+		t.Errorf("MergeSorted yielded %v", got)
+	}
+}
+
+func TestMergeSortedFunc(t *testing.T) {
+	desc := func(a, b int) int { return cmp.Compare(b, a) }
+	seq := MergeSortedFunc(desc, slices.Values([]int{7, 4, 1}), slices.Values([]int{9, 8, 3, 2}))
+	checkLaws(t, seqtest.Check(seq, 8))
+	if got := slices.Collect(seq); !slices.Equal(got, []int{9, 8, 7, 4, 3, 2, 1}) {
+		t.Errorf("MergeSortedFunc yielded %v", got)
+	}
+}
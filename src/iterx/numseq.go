@@ -0,0 +1,32 @@
+package iterx
+
+import (
+	"iter"
+
+	"ROFT_examples/constraints"
+)
+
+// Sum returns the sum of all elements of seq, or zero for an empty sequence
+func Sum[T constraints.Number | constraints.Complex](seq iter.Seq[T]) T {
+	var sum T
+	for v := range seq {
+		sum += v
+	}
+	return sum
+}
+
+// Mean returns the arithmetic mean of the elements of seq and false if seq is empty
+func Mean[T constraints.Number](seq iter.Seq[T]) (float64, bool) {
+	var (
+		sum float64
+		n   int
+	)
+	for v := range seq {
+		sum += float64(v)
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
@@ -0,0 +1,21 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	if got := Sum(ints(5)); got != 10 {
+		t.Errorf("Sum = %d", got)
+	}
+	if got := Sum(slices.Values([]complex128{1 + 2i, 3i})); got != 1+5i {
+		t.Errorf("Sum = %v", got)
+	}
+	if m, ok := Mean(ints(4)); !ok || m != 1.5 {
+		t.Errorf("Mean = %v, %v", m, ok)
+	}
+	if _, ok := Mean(ints(0)); ok {
+		t.Error("Mean of an empty sequence reported ok")
+	}
+}
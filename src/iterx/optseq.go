@@ -0,0 +1,18 @@
+package iterx
+
+import (
+	"iter"
+
+	"ROFT_examples/collections"
+)
+
+// FilterSome yields the values of the elements of seq that are not None
+func FilterSome[V any](seq iter.Seq[collections.Option[V]]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for o := range seq {
+			if v, ok := o.Get(); ok && !yield(v) {
+				return
+			}
+		}
+	}
+}
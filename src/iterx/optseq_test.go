@@ -0,0 +1,17 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+
+	"ROFT_examples/collections"
+	"ROFT_examples/seqtest"
+)
+
+func TestFilterSome(t *testing.T) {
+	opts := slices.Values([]collections.Option[int]{collections.Some(1), collections.None[int](), collections.Some(3)})
+	checkLaws(t, seqtest.Check(FilterSome(opts), 4))
+	if got := slices.Collect(FilterSome(opts)); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("FilterSome yielded %v", got)
+	}
+}
@@ -0,0 +1,27 @@
+package iterx
+
+import "iter"
+
+func Pairwise[V any](seq iter.Seq[V]) iter.Seq2[V, V] {
+	return func(yield func(V, V) bool) {
+		// Pull from seq only once so that single-use sources are not consumed twice
+		next, stop := iter.Pull(seq)
+		defer stop()
+
+		v1, ok := next()
+		if !ok {
+			return
+		}
+		for {
+			v2, ok := next()
+			if !ok {
+				return
+			}
+			// Yield the pair of values
+			if !yield(v1, v2) {
+				return
+			}
+			v1 = v2
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// Pipeline runs sequence-transforming stages on their own goroutines.
+// The first stage error cancels all stages and is returned by Wait.
+type Pipeline struct {
+	parent  context.Context
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	err     error
+	stopped bool
+}
+
+// NewPipeline creates a pipeline whose stages are canceled together with ctx
+func NewPipeline(ctx context.Context) *Pipeline {
+	inner, cancel := context.WithCancel(ctx)
+	return &Pipeline{parent: ctx, ctx: inner, cancel: cancel}
+}
+
+// Context returns the context shared by all stages of the pipeline
+func (p *Pipeline) Context() context.Context {
+	return p.ctx
+}
+
+func (p *Pipeline) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// Cancellation caused by an early stop of the consumer is not an error
+	if p.stopped && errors.Is(err, context.Canceled) {
+		return
+	}
+	if p.err == nil {
+		p.err = err
+	}
+	p.cancel()
+}
+
+func (p *Pipeline) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stopped = true
+	p.cancel()
+}
+
+// Wait blocks until all stages have returned and reports the first error.
+// The output of the last stage has to be consumed before calling Wait.
+func (p *Pipeline) Wait() error {
+	p.wg.Wait()
+	p.cancel()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil && !p.stopped {
+		return p.parent.Err()
+	}
+	return p.err
+}
+
+// AddStage starts stage in a new goroutine, consuming in and buffering up to buf emitted values.
+// emit reports false once the pipeline is canceled, after which the stage should return.
+// Stopping the iteration over the returned sequence early stops the whole pipeline.
+func AddStage[In, Out any](p *Pipeline, in iter.Seq[In], buf int,
+	stage func(ctx context.Context, in iter.Seq[In], emit func(Out) bool) error) iter.Seq[Out] {
+	ch := make(chan Out, max(buf, 0))
+	emit := func(v Out) bool {
+		select {
+		case ch <- v:
+			return true
+		case <-p.ctx.Done():
+			return false
+		}
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer close(ch)
+		if err := stage(p.ctx, WithContext(p.ctx, in), emit); err != nil {
+			p.fail(err)
+		}
+	}()
+
+	return func(yield func(Out) bool) {
+		for v := range ch {
+			if !yield(v) {
+				p.stop()
+				return
+			}
+		}
+	}
+}
+
+// MapStage adds a stage applying fn to every element; an error from fn fails the pipeline
+func MapStage[In, Out any](p *Pipeline, in iter.Seq[In], buf int, fn func(context.Context, In) (Out, error)) iter.Seq[Out] {
+	return AddStage(p, in, buf, func(ctx context.Context, in iter.Seq[In], emit func(Out) bool) error {
+		for v := range in {
+			u, err := fn(ctx, v)
+			if err != nil {
+				return err
+			}
+			if !emit(u) {
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func double(_ context.Context, v int) (int, error) {
+	return 2 * v, nil
+}
+
+// pipelineSeq builds a fresh two-stage pipeline for every iteration and waits for it when the iteration ends
+func pipelineSeq(t *testing.T, n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		p := NewPipeline(context.Background())
+		filtered := AddStage(p, ints(n), 1, func(_ context.Context, in iter.Seq[int], emit func(int) bool) error {
+			for v := range in {
+				if v%2 == 0 && !emit(v) {
+					return nil
+				}
+			}
+			return nil
+		})
+		for v := range MapStage(p, filtered, 1, double) {
+			if !yield(v) {
+				break
+			}
+		}
+		if err := p.Wait(); err != nil {
+			t.Errorf("Wait() = %v", err)
+		}
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	seq := pipelineSeq(t, 10)
+	checkLaws(t, seqtest.Check(seq, 6))
+	if got := slices.Collect(seq); !slices.Equal(got, []int{0, 4, 8, 12, 16}) {
+		t.Errorf("pipeline yielded %v", got)
+	}
+}
+
+func TestPipelineError(t *testing.T) {
+	errBoom := errors.New("boom")
+	checkLaws(t, seqtest.NoLeak(func() {
+		p := NewPipeline(context.Background())
+		out := MapStage(p, ints(100), 0, func(_ context.Context, v int) (int, error) {
+			if v == 3 {
+				return 0, errBoom
+			}
+			return v, nil
+		})
+		for range out {
+		}
+		if err := p.Wait(); !errors.Is(err, errBoom) {
+			t.Errorf("Wait() = %v, want %v", err, errBoom)
+		}
+	}))
+}
@@ -0,0 +1,28 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"os"
+	"os/signal"
+)
+
+// Signals yields the incoming signals sig, or all signals if none are given, until ctx is done or the
+// consumer stops. The signals are only intercepted while the sequence is being iterated.
+func Signals(ctx context.Context, sig ...os.Signal) iter.Seq[os.Signal] {
+	return func(yield func(os.Signal) bool) {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, sig...)
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case s := <-ch:
+				if !yield(s) {
+					return
+				}
+			}
+		}
+	}
+}
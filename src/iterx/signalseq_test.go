@@ -0,0 +1,39 @@
+//go:build unix
+
+package iterx
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// raise sends sig to the own process every millisecond until ctx is done
+func raise(ctx context.Context, sig syscall.Signal) {
+	for ctx.Err() == nil {
+		syscall.Kill(os.Getpid(), sig)
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSignals(t *testing.T) {
+	// The first Notify starts the runtime's signal loop, which would otherwise count as a leaked goroutine
+	warm := make(chan os.Signal, 1)
+	signal.Notify(warm, syscall.SIGUSR1)
+	defer signal.Stop(warm)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go raise(ctx, syscall.SIGUSR1)
+	// raise is started before the checks so it is part of the goroutine baseline
+	checkLaws(t, checkStream(Signals(context.Background(), syscall.SIGUSR1), 3))
+	for s := range Signals(context.Background(), syscall.SIGUSR1) {
+		if s != syscall.SIGUSR1 {
+			t.Errorf("Signals yielded %v", s)
+		}
+		break
+	}
+}
@@ -0,0 +1,73 @@
+package iterx
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// SingleFlightSeq deduplicates concurrent requests for the sequence of a key.
+// The sequence is enumerated once per key while requests overlap, and its values are replayed to every waiter.
+type SingleFlightSeq[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*flight[V]
+	fn    func(K) iter.Seq[V]
+}
+
+type flight[V any] struct {
+	done     chan struct{}
+	values   []V
+	panicked any
+}
+
+func NewSingleFlightSeq[K comparable, V any](fn func(K) iter.Seq[V]) *SingleFlightSeq[K, V] {
+	return &SingleFlightSeq[K, V]{calls: make(map[K]*flight[V]), fn: fn}
+}
+
+// Do returns all values of the sequence for key, sharing the enumeration with concurrent callers for the same key.
+// Every caller gets its own copy of the values, so it may modify the result.
+func (s *SingleFlightSeq[K, V]) Do(key K) []V {
+	return slices.Clone(s.do(key))
+}
+
+// do is Do without the copy. The returned slice is shared with the other callers and must not be modified.
+func (s *SingleFlightSeq[K, V]) do(key K) []V {
+	s.mu.Lock()
+	if f, ok := s.calls[key]; ok {
+		s.mu.Unlock()
+		<-f.done
+		if f.panicked != nil {
+			panic(f.panicked)
+		}
+		return f.values
+	}
+	f := &flight[V]{done: make(chan struct{})}
+	s.calls[key] = f
+	s.mu.Unlock()
+
+	defer func() {
+		if v := recover(); v != nil {
+			f.panicked = v
+		}
+		s.mu.Lock()
+		delete(s.calls, key)
+		s.mu.Unlock()
+		close(f.done)
+		if f.panicked != nil {
+			panic(f.panicked)
+		}
+	}()
+	f.values = slices.Collect(s.fn(key))
+	return f.values
+}
+
+// Seq returns an iterator replaying the shared result of Do for key
+func (s *SingleFlightSeq[K, V]) Seq(key K) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range s.do(key) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package iterx
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestSingleFlightSeq(t *testing.T) {
+	s := NewSingleFlightSeq(func(n int) iter.Seq[int] {
+		return ints(n)
+	})
+	checkLaws(t, seqtest.Check(s.Seq(4), 5))
+	if got := slices.Collect(s.Seq(4)); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Seq yielded %v", got)
+	}
+
+	// concurrent callers all see the complete result, whether or not they shared the enumeration
+	var wg sync.WaitGroup
+	results := make([][]int, 4)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = s.Do(3)
+		}()
+	}
+	wg.Wait()
+	for _, r := range results {
+		if !slices.Equal(r, []int{0, 1, 2}) {
+			t.Errorf("Do yielded %v", r)
+		}
+	}
+}
+
+func TestSingleFlightSeqDoCopies(t *testing.T) {
+	release := make(chan struct{})
+	s := NewSingleFlightSeq(func(n int) iter.Seq[int] {
+		<-release
+		return ints(n)
+	})
+	var wg sync.WaitGroup
+	results := make([][]int, 4)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = s.Do(3)
+			results[i][0] = i + 10
+		}()
+	}
+	// give the callers time to join the first enumeration
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for i, r := range results {
+		if !slices.Equal(r, []int{i + 10, 1, 2}) {
+			t.Errorf("caller %d sees %v after modifying its result", i, r)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package iterx
+
+import (
+	"iter"
+	"slices"
+)
+
+// Chunk groups the elements of seq into slices of n elements, the last one possibly shorter.
+// Every chunk is a new slice. It panics if n < 1.
+func Chunk[V any](seq iter.Seq[V], n int) iter.Seq[[]V] {
+	if n < 1 {
+		panic("chunk: size must be at least 1")
+	}
+	return func(yield func([]V) bool) {
+		buf := make([]V, 0, n)
+		for v := range seq {
+			buf = append(buf, v)
+			if len(buf) == n {
+				if !yield(buf) {
+					return
+				}
+				buf = make([]V, 0, n)
+			}
+		}
+		if len(buf) > 0 {
+			yield(buf)
+		}
+	}
+}
+
+// ChunkSlice yields consecutive sub-slices of s with n elements, the last one possibly shorter.
+// The chunks share memory with s and have their capacity capped, so appending to them does not overwrite s.
+// It panics if n < 1.
+func ChunkSlice[V any](s []V, n int) iter.Seq[[]V] {
+	return slices.Chunk(s, n)
+}
+
+// WindowsSlice yields every run of n consecutive elements of s as a sub-slice view without copying.
+// Nothing is yielded if s is shorter than n. It panics if n < 1.
+func WindowsSlice[V any](s []V, n int) iter.Seq[[]V] {
+	if n < 1 {
+		panic("windows: size must be at least 1")
+	}
+	return func(yield func([]V) bool) {
+		for i := 0; i+n <= len(s); i++ {
+			if !yield(s[i : i+n : i+n]) {
+				return
+			}
+		}
+	}
+}
+
+// DedupSlice removes repeated elements from s in place, keeping the first occurrence of each,
+// and returns the shortened slice. Unlike slices.Compact it does not require sorted input.
+func DedupSlice[V comparable](s []V) []V {
+	seen := make(map[V]struct{}, len(s))
+	out := s[:0]
+	for _, v := range s {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	clear(s[len(out):])
+	return out
+}
+
+// DedupSliceFunc is DedupSlice with a custom equality function. It takes O(n²) comparisons.
+func DedupSliceFunc[V any](s []V, eq func(a, b V) bool) []V {
+	out := s[:0]
+	for _, v := range s {
+		if !slices.ContainsFunc(out, func(u V) bool { return eq(u, v) }) {
+			out = append(out, v)
+		}
+	}
+	clear(s[len(out):])
+	return out
+}
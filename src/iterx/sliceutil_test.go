@@ -0,0 +1,31 @@
+package iterx
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestChunk(t *testing.T) {
+	checkLaws(t, seqtest.Check(Chunk(ints(7), 3), 4))
+	checkLaws(t, seqtest.Check(ChunkSlice(slices.Collect(ints(7)), 3), 4))
+	if got := slices.Collect(Chunk(ints(7), 3)); len(got) != 3 || !slices.Equal(got[2], []int{6}) {
+		t.Errorf("Chunk yielded %v", got)
+	}
+}
+
+func TestWindowsSlice(t *testing.T) {
+	checkLaws(t, seqtest.Check(WindowsSlice(slices.Collect(ints(5)), 3), 4))
+}
+
+func TestDedupSlice(t *testing.T) {
+	if got := DedupSlice([]int{3, 1, 3, 2, 1}); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("DedupSlice = %v", got)
+	}
+	got := DedupSliceFunc([]string{"a", "B", "A", "b", "c"}, strings.EqualFold)
+	if !slices.Equal(got, []string{"a", "B", "c"}) {
+		t.Errorf("DedupSliceFunc = %v", got)
+	}
+}
@@ -0,0 +1,23 @@
+package iterx
+
+import (
+	"database/sql"
+	"iter"
+)
+
+// Rows yields every row of rows converted by scan and closes rows when iteration ends, including when the
+// consumer stops early. Scan errors are yielded per row; an error from rows.Err is yielded last.
+func Rows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer rows.Close()
+		for rows.Next() {
+			if !yield(scan(rows)) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package iterx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+// countDriver serves a single query returning the integers 0..n-1, where n is the query text,
+// and counts how many of its row sets are still open
+type countDriver struct {
+	open atomic.Int64
+}
+
+type countConn struct{ d *countDriver }
+
+type countRows struct {
+	d    *countDriver
+	n, i int
+}
+
+func (d *countDriver) Open(string) (driver.Conn, error) { return countConn{d}, nil }
+func (c countConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c countConn) Close() error                        { return nil }
+func (c countConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+func (r *countRows) Columns() []string                  { return []string{"n"} }
+func (r *countRows) Close() error                       { r.d.open.Add(-1); return nil }
+func (c countConn) Query(query string, _ []driver.Value) (driver.Rows, error) {
+	n, err := strconv.Atoi(query)
+	if err != nil {
+		return nil, err
+	}
+	c.d.open.Add(1)
+	return &countRows{d: c.d, n: n}, nil
+}
+
+func (r *countRows) Next(dest []driver.Value) error {
+	if r.i == r.n {
+		return io.EOF
+	}
+	dest[0] = int64(r.i)
+	r.i++
+	return nil
+}
+
+var sqlDriver = &countDriver{}
+
+func init() {
+	sql.Register("iterx-count", sqlDriver)
+}
+
+func TestRows(t *testing.T) {
+	db, err := sql.Open("iterx-count", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	scan := func(rows *sql.Rows) (int, error) {
+		var n int
+		err := rows.Scan(&n)
+		return n, err
+	}
+	seq := fresh2(func() iter.Seq2[int, error] {
+		rows, err := db.Query("4")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Rows(rows, scan)
+	})
+	checkLaws(t, seqtest.Check2(seq, 5))
+	if got := slices.Collect(keys(seq)); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Rows yielded %v", got)
+	}
+	if n := sqlDriver.open.Load(); n != 0 {
+		t.Errorf("%d row sets left open", n)
+	}
+}
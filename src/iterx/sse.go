@@ -0,0 +1,76 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Event is a single message of a text/event-stream. An empty Type means the default "message" type.
+type Event struct {
+	ID    string
+	Type  string
+	Data  string
+	Retry int
+}
+
+// SSE parses the body of resp as server-sent events and closes it when iteration ends. Cancelling ctx
+// closes the body, which unblocks a pending read; the cancellation error is then yielded.
+func SSE(ctx context.Context, resp *http.Response) iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		stop := context.AfterFunc(ctx, func() { resp.Body.Close() })
+		defer func() {
+			stop()
+			resp.Body.Close()
+		}()
+		var (
+			ev   Event
+			data []string
+		)
+		for line, err := range Lines(resp.Body) {
+			if ctx.Err() != nil {
+				yield(Event{}, ctx.Err())
+				return
+			}
+			if err != nil {
+				yield(Event{}, err)
+				return
+			}
+			if line == "" {
+				if data != nil {
+					ev.Data = strings.Join(data, "\n")
+					if !yield(ev, nil) {
+						return
+					}
+				}
+				// the last event ID persists across events
+				ev, data = Event{ID: ev.ID}, nil
+				continue
+			}
+			if strings.HasPrefix(line, ":") {
+				continue
+			}
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "data":
+				data = append(data, value)
+			case "event":
+				ev.Type = value
+			case "id":
+				if !strings.Contains(value, "\x00") {
+					ev.ID = value
+				}
+			case "retry":
+				if n, err := strconv.Atoi(value); err == nil {
+					ev.Retry = n
+				}
+			}
+		}
+		if ctx.Err() != nil {
+			yield(Event{}, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+const sampleSSE = ": comment\nid: 1\nevent: greeting\ndata: hello\ndata: world\n\ndata: second\nretry: 50\n\n"
+
+// closeTracker records whether the body was closed. Like a real response body, it may be closed
+// concurrently by the cancellation and by the end of the iteration.
+type closeTracker struct {
+	io.Reader
+	closed atomic.Bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func sseResponse(body string) (*http.Response, *closeTracker) {
+	b := &closeTracker{Reader: strings.NewReader(body)}
+	return &http.Response{Body: b}, b
+}
+
+func TestSSE(t *testing.T) {
+	seq := fresh2(func() iter.Seq2[Event, error] {
+		resp, _ := sseResponse(sampleSSE)
+		return SSE(context.Background(), resp)
+	})
+	checkLaws(t, seqtest.Check2(seq, 3))
+	want := []Event{{ID: "1", Type: "greeting", Data: "hello\nworld"}, {ID: "1", Data: "second", Retry: 50}}
+	if got := slices.Collect(keys(seq)); !slices.Equal(got, want) {
+		t.Errorf("SSE yielded %+v, want %+v", got, want)
+	}
+
+	resp, body := sseResponse(sampleSSE)
+	for range SSE(context.Background(), resp) {
+		break
+	}
+	if !body.closed.Load() {
+		t.Error("body not closed after an early stop")
+	}
+}
+
+func TestSSECanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, _ := sseResponse(sampleSSE)
+	for _, err := range SSE(ctx, resp) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("canceled SSE yielded %v", err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// Debounce yields an element only once no other element followed it within quiet, based on the element timestamps
+func Debounce[V any](seq iter.Seq2[time.Time, V], quiet time.Duration) iter.Seq2[time.Time, V] {
+	return func(yield func(time.Time, V) bool) {
+		var (
+			pendingT time.Time
+			pendingV V
+			pending  bool
+		)
+		for t, v := range seq {
+			if pending && t.Sub(pendingT) >= quiet {
+				if !yield(pendingT, pendingV) {
+					return
+				}
+			}
+			pendingT, pendingV, pending = t, v, true
+		}
+		if pending {
+			yield(pendingT, pendingV)
+		}
+	}
+}
+
+// Throttle yields at most one element per interval, dropping elements that arrive too soon after the last yielded one
+func Throttle[V any](seq iter.Seq2[time.Time, V], interval time.Duration) iter.Seq2[time.Time, V] {
+	return func(yield func(time.Time, V) bool) {
+		var (
+			last    time.Time
+			emitted bool
+		)
+		for t, v := range seq {
+			if emitted && t.Sub(last) < interval {
+				continue
+			}
+			if !yield(t, v) {
+				return
+			}
+			last, emitted = t, true
+		}
+	}
+}
+
+// Ticks yields the current time every d until ctx is done or the consumer stops. Ticks are dropped
+// when the consumer is slower than d, like with time.Ticker.
+func Ticks(ctx context.Context, d time.Duration) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		t := time.NewTicker(d)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-t.C:
+				if !yield(now) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// After yields the current time once after d has passed
+func After(d time.Duration) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		yield(<-time.After(d))
+	}
+}
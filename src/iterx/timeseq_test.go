@@ -0,0 +1,65 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"testing"
+	"time"
+
+	"ROFT_examples/seqtest"
+)
+
+// stamped yields the values with timestamps offset by the given number of seconds from a fixed base
+func stamped(offsets ...int) iter.Seq2[time.Time, int] {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func(yield func(time.Time, int) bool) {
+		for i, s := range offsets {
+			if !yield(base.Add(time.Duration(s)*time.Second), i) {
+				return
+			}
+		}
+	}
+}
+
+func values[K, V any](seq iter.Seq2[K, V]) []V {
+	var s []V
+	for _, v := range seq {
+		s = append(s, v)
+	}
+	return s
+}
+
+func TestDebounce(t *testing.T) {
+	seq := Debounce(stamped(0, 1, 5, 6, 7, 20), 3*time.Second)
+	checkLaws(t, seqtest.Check2(seq, 4))
+	if got := values(seq); !slices.Equal(got, []int{1, 4, 5}) {
+		t.Errorf("Debounce yielded %v", got)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	seq := Throttle(stamped(0, 1, 2, 3, 4, 5), 2*time.Second)
+	checkLaws(t, seqtest.Check2(seq, 4))
+	if got := values(seq); !slices.Equal(got, []int{0, 2, 4}) {
+		t.Errorf("Throttle yielded %v", got)
+	}
+}
+
+func TestTicks(t *testing.T) {
+	checkLaws(t, checkStream(Ticks(context.Background(), time.Millisecond), 3))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	for range Ticks(ctx, time.Millisecond) {
+	}
+	if ctx.Err() == nil {
+		t.Error("Ticks returned before its context was done")
+	}
+}
+
+func TestAfter(t *testing.T) {
+	checkLaws(t, checkStream(After(time.Millisecond), 2))
+	if n := len(slices.Collect(After(time.Millisecond))); n != 1 {
+		t.Errorf("After yielded %d times", n)
+	}
+}
@@ -0,0 +1,36 @@
+package iterx
+
+import (
+	"io/fs"
+	"iter"
+
+	"ROFT_examples/collections"
+)
+
+// WalkDir yields every path below root in lexical order together with its entry. Paths that cannot be
+// read are skipped; use WalkDirErr to see those errors.
+func WalkDir(fsys fs.FS, root string) iter.Seq2[string, fs.DirEntry] {
+	return func(yield func(string, fs.DirEntry) bool) {
+		for e, err := range WalkDirErr(fsys, root) {
+			if err != nil {
+				continue
+			}
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// WalkDirErr is like WalkDir but also yields the errors reported by fs.WalkDir. The entry is nil if root
+// itself could not be read.
+func WalkDirErr(fsys fs.FS, root string) iter.Seq2[collections.Pair[string, fs.DirEntry], error] {
+	return func(yield func(collections.Pair[string, fs.DirEntry], error) bool) {
+		fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+			if !yield(collections.Pair[string, fs.DirEntry]{Key: path, Value: d}, err) {
+				return fs.SkipAll
+			}
+			return nil
+		})
+	}
+}
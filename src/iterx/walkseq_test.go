@@ -0,0 +1,24 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestWalkDir(t *testing.T) {
+	fsys := fstest.MapFS{"a/x.txt": {}, "a/y.txt": {}, "b.txt": {}}
+	checkLaws(t, seqtest.Check(keys(WalkDir(fsys, ".")), 7))
+	checkLaws(t, seqtest.Check2(WalkDirErr(fsys, "."), 7))
+	want := []string{".", "a", "a/x.txt", "a/y.txt", "b.txt"}
+	if got := slices.Collect(keys(WalkDir(fsys, "."))); !slices.Equal(got, want) {
+		t.Errorf("WalkDir yielded %v, want %v", got, want)
+	}
+	for p, err := range WalkDirErr(fsys, "missing") {
+		if err == nil || p.Value != nil {
+			t.Errorf("walking a missing root yielded %v, %v", p, err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package iterx
+
+import (
+	"fmt"
+	"io"
+	"iter"
+)
+
+// WriteSeq writes the elements of seq to w, formatted by format and separated by sep, and stops at the first write error
+func WriteSeq[T any](w io.Writer, seq iter.Seq[T], format func(T) string, sep string) error {
+	first := true
+	for v := range seq {
+		if !first {
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+		}
+		first = false
+		if _, err := io.WriteString(w, format(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FprintSeq writes every element of seq on its own line using fmt's default formatting
+func FprintSeq[T any](w io.Writer, seq iter.Seq[T]) error {
+	for v := range seq {
+		if _, err := fmt.Fprintln(w, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FprintSeq2 writes every pair of seq on its own line, separated by a space
+func FprintSeq2[K, V any](w io.Writer, seq iter.Seq2[K, V]) error {
+	for k, v := range seq {
+		if _, err := fmt.Fprintln(w, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package iterx
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteSeq(t *testing.T) {
+	var b strings.Builder
+	if err := WriteSeq(&b, ints(3), strconv.Itoa, ", "); err != nil || b.String() != "0, 1, 2" {
+		t.Errorf("WriteSeq wrote %q, %v", b.String(), err)
+	}
+	b.Reset()
+	if err := FprintSeq(&b, ints(2)); err != nil || b.String() != "0\n1\n" {
+		t.Errorf("FprintSeq wrote %q, %v", b.String(), err)
+	}
+	b.Reset()
+	if err := FprintSeq2(&b, Pairwise(ints(3))); err != nil || b.String() != "0 1\n1 2\n" {
+		t.Errorf("FprintSeq2 wrote %q, %v", b.String(), err)
+	}
+}
@@ -0,0 +1,49 @@
+package iterx
+
+import (
+	"iter"
+
+	"ROFT_examples/collections"
+)
+
+// Zip yields the elements of a and b in lockstep and stops at the end of the shorter sequence
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Zip3 yields the elements of three sequences in lockstep as Triples and stops at the end of the shortest
+func Zip3[A, B, C any](a iter.Seq[A], b iter.Seq[B], c iter.Seq[C]) iter.Seq[collections.Triple[A, B, C]] {
+	return func(yield func(collections.Triple[A, B, C]) bool) {
+		nextC, stop := iter.Pull(c)
+		defer stop()
+		for va, vb := range Zip(a, b) {
+			vc, ok := nextC()
+			if !ok || !yield(collections.TripleOf(va, vb, vc)) {
+				return
+			}
+		}
+	}
+}
+
+// Zip4 yields the elements of four sequences in lockstep as Quads and stops at the end of the shortest
+func Zip4[A, B, C, D any](a iter.Seq[A], b iter.Seq[B], c iter.Seq[C], d iter.Seq[D]) iter.Seq[collections.Quad[A, B, C, D]] {
+	return func(yield func(collections.Quad[A, B, C, D]) bool) {
+		nextD, stop := iter.Pull(d)
+		defer stop()
+		for t := range Zip3(a, b, c) {
+			vd, ok := nextD()
+			if !ok || !yield(collections.QuadOf(t.First, t.Second, t.Third, vd)) {
+				return
+			}
+		}
+	}
+}
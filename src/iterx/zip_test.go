@@ -0,0 +1,21 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+
+	"ROFT_examples/seqtest"
+)
+
+func TestPairwise(t *testing.T) {
+	checkLaws(t, seqtest.Check2(Pairwise(ints(5)), 5))
+}
+
+func TestZip(t *testing.T) {
+	checkLaws(t, seqtest.Check2(Zip(ints(4), ints(6)), 5))
+	checkLaws(t, seqtest.Check(Zip3(ints(4), ints(6), ints(5)), 5))
+	checkLaws(t, seqtest.Check(Zip4(ints(4), ints(6), ints(5), ints(3)), 5))
+	if got := slices.Collect(Zip4(ints(4), ints(6), ints(5), ints(3))); len(got) != 3 {
+		t.Errorf("Zip4 yielded %d elements, want 3", len(got))
+	}
+}
@@ -0,0 +1,43 @@
+package maputil
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+func CollectMapValues[K comparable, V any](m map[K]V) []V {
+	return slices.Collect(maps.Values(m))
+}
+
+func CollectMapsValuesSorted[K comparable, V cmp.Ordered](m map[K]V) []V {
+	return slices.Sorted(maps.Values(m))
+}
+
+// CollectMapsValuesSortedDesc returns the values of m in descending order
+func CollectMapsValuesSortedDesc[K comparable, V cmp.Ordered](m map[K]V) []V {
+	return CollectMapsValuesSortedFunc(m, func(a, b V) int { return cmp.Compare(b, a) })
+}
+
+// CollectMapsValuesSortedFunc returns the values of m sorted by cmp
+func CollectMapsValuesSortedFunc[K comparable, V any](m map[K]V, cmp func(a, b V) int) []V {
+	return slices.SortedFunc(maps.Values(m), cmp)
+}
+
+func CollectMapKeys[K comparable, V any](m map[K]V) []K {
+	return slices.Collect(maps.Keys(m))
+}
+
+func CollectMapKeysSorted[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
+
+// CollectMapKeysSortedDesc returns the keys of m in descending order
+func CollectMapKeysSortedDesc[K cmp.Ordered, V any](m map[K]V) []K {
+	return CollectMapKeysSortedFunc(m, func(a, b K) int { return cmp.Compare(b, a) })
+}
+
+// CollectMapKeysSortedFunc returns the keys of m sorted by cmp
+func CollectMapKeysSortedFunc[K comparable, V any](m map[K]V, cmp func(a, b K) int) []K {
+	return slices.SortedFunc(maps.Keys(m), cmp)
+}
@@ -0,0 +1,2 @@
+// Package maputil provides helpers for transforming, querying and printing built-in maps.
+package maputil
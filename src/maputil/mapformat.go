@@ -0,0 +1,53 @@
+package maputil
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// FormatOption configures FormatMap. It is not named Option to keep that name free for an optional value type.
+type FormatOption[V any] func(*formatConfig[V])
+
+type formatConfig[V any] struct {
+	value  func(V) string
+	sep    string
+	indent string
+}
+
+// WithValueFormatter sets how values are printed. The default is fmt's %v.
+func WithValueFormatter[V any](f func(V) string) FormatOption[V] {
+	return func(c *formatConfig[V]) { c.value = f }
+}
+
+// WithSeparator sets the text between a key and its value. The default is ": ".
+func WithSeparator[V any](sep string) FormatOption[V] {
+	return func(c *formatConfig[V]) { c.sep = sep }
+}
+
+// WithIndent sets a prefix written before every line
+func WithIndent[V any](indent string) FormatOption[V] {
+	return func(c *formatConfig[V]) { c.indent = indent }
+}
+
+// FormatMap prints one entry per line in ascending key order, padding the keys so the values line up
+func FormatMap[K cmp.Ordered, V any](m map[K]V, opts ...FormatOption[V]) string {
+	cfg := formatConfig[V]{value: func(v V) string { return fmt.Sprint(v) }, sep: ": "}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	keys := slices.Sorted(maps.Keys(m))
+	names := make([]string, len(keys))
+	width := 0
+	for i, k := range keys {
+		names[i] = fmt.Sprint(k)
+		width = max(width, len(names[i]))
+	}
+	var b strings.Builder
+	for i, k := range keys {
+		fmt.Fprintf(&b, "%s%-*s%s%s\n", cfg.indent, width, names[i], cfg.sep, cfg.value(m[k]))
+	}
+	return b.String()
+}
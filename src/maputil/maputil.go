@@ -0,0 +1,554 @@
+package maputil
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"ROFT_examples/collections"
+)
+
+// InvertMap swaps keys and values. If several keys share a value, an arbitrary one of them is kept;
+// use InvertMapMulti to keep all of them.
+func InvertMap[K, V comparable](m map[K]V) map[V]K {
+	r := make(map[V]K, len(m))
+	for k, v := range m {
+		r[v] = k
+	}
+	return r
+}
+
+// InvertMapMulti maps every value to all keys it appears under, in unspecified order
+func InvertMapMulti[K, V comparable](m map[K]V) map[V][]K {
+	r := make(map[V][]K)
+	for k, v := range m {
+		r[v] = append(r[v], k)
+	}
+	return r
+}
+
+// MergeMaps combines ms into a new map. For keys present in more than one map, resolve is called with
+// the key, the value merged so far and the value of the later map, and its result is stored.
+func MergeMaps[K comparable, V any](resolve func(key K, existing, incoming V) V, ms ...map[K]V) map[K]V {
+	size := 0
+	for _, m := range ms {
+		size = max(size, len(m))
+	}
+	r := make(map[K]V, size)
+	for _, m := range ms {
+		for k, v := range m {
+			if old, ok := r[k]; ok {
+				v = resolve(k, old, v)
+			}
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// FilterMap returns a new map with the entries of m for which pred returns true
+func FilterMap[K comparable, V any](m map[K]V, pred func(K, V) bool) map[K]V {
+	r := make(map[K]V)
+	for k, v := range m {
+		if pred(k, v) {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// RetainMap deletes the entries of m for which pred returns false, modifying m in place
+func RetainMap[K comparable, V any](m map[K]V, pred func(K, V) bool) {
+	maps.DeleteFunc(m, func(k K, v V) bool {
+		return !pred(k, v)
+	})
+}
+
+// MapValuesT returns a map with the same keys as m and every value converted by f
+func MapValuesT[K comparable, V1, V2 any](m map[K]V1, f func(V1) V2) map[K]V2 {
+	r := make(map[K]V2, len(m))
+	for k, v := range m {
+		r[k] = f(v)
+	}
+	return r
+}
+
+// MapKeysT returns a map with every key of m converted by f. If several keys map to the same new key,
+// resolve combines their values like in MergeMaps. A nil resolve keeps an arbitrary one of them.
+func MapKeysT[K1, K2 comparable, V any](m map[K1]V, f func(K1) K2, resolve func(key K2, existing, incoming V) V) map[K2]V {
+	r := make(map[K2]V, len(m))
+	for k, v := range m {
+		nk := f(k)
+		if old, ok := r[nk]; ok && resolve != nil {
+			v = resolve(nk, old, v)
+		}
+		r[nk] = v
+	}
+	return r
+}
+
+// SortOrder selects the direction of a sort
+type SortOrder int
+
+const (
+	Ascending SortOrder = iota
+	Descending
+)
+
+// CollectEntriesSortedByValue returns the entries of m sorted by value in the given order.
+// The order of entries with equal values is unspecified.
+func CollectEntriesSortedByValue[K comparable, V cmp.Ordered](m map[K]V, order SortOrder) []collections.Pair[K, V] {
+	entries := collectEntries(m)
+	slices.SortFunc(entries, func(a, b collections.Pair[K, V]) int {
+		if order == Descending {
+			return cmp.Compare(b.Value, a.Value)
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return entries
+}
+
+func collectEntries[K comparable, V any](m map[K]V) []collections.Pair[K, V] {
+	entries := make([]collections.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, collections.Pair[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+// SortEntriesBy sorts entries stably by the comparators in order, falling through to the next one on ties
+func SortEntriesBy[K, V any](entries []collections.Pair[K, V], less ...func(a, b collections.Pair[K, V]) int) {
+	slices.SortStableFunc(entries, func(a, b collections.Pair[K, V]) int {
+		for _, f := range less {
+			if c := f(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// AllSortedFunc returns an iterator over the entries of m in the order defined by cmp
+func AllSortedFunc[K comparable, V any](m map[K]V, cmp func(a, b collections.Pair[K, V]) int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		entries := collectEntries(m)
+		slices.SortFunc(entries, cmp)
+		for _, e := range entries {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// AllSortedByKey returns an iterator over the entries of m in ascending key order
+func AllSortedByKey[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return AllSortedFunc(m, func(a, b collections.Pair[K, V]) int { return cmp.Compare(a.Key, b.Key) })
+}
+
+// AllSortedByValue returns an iterator over the entries of m in ascending value order.
+// Entries with equal values come in no particular order.
+func AllSortedByValue[K comparable, V cmp.Ordered](m map[K]V) iter.Seq2[K, V] {
+	return AllSortedFunc(m, func(a, b collections.Pair[K, V]) int { return cmp.Compare(a.Value, b.Value) })
+}
+
+// GroupToMap collects the elements of seq into lists keyed by key, keeping their order within each list
+func GroupToMap[V any, K comparable](seq iter.Seq[V], key func(V) K) map[K][]V {
+	r := make(map[K][]V)
+	for v := range seq {
+		k := key(v)
+		r[k] = append(r[k], v)
+	}
+	return r
+}
+
+// GroupSlice is GroupToMap for a slice
+func GroupSlice[V any, K comparable](items []V, key func(V) K) map[K][]V {
+	return GroupToMap(slices.Values(items), key)
+}
+
+// ErrDuplicateKey is returned by helpers that build maps and were asked to reject duplicate keys
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// IndexBy builds a map from key(item) to item. For duplicate keys the last item wins.
+func IndexBy[V any, K comparable](items []V, key func(V) K) map[K]V {
+	r := make(map[K]V, len(items))
+	for _, v := range items {
+		r[key(v)] = v
+	}
+	return r
+}
+
+// IndexByUnique is like IndexBy but fails with ErrDuplicateKey if two items have the same key
+func IndexByUnique[V any, K comparable](items []V, key func(V) K) (map[K]V, error) {
+	r := make(map[K]V, len(items))
+	for _, v := range items {
+		k := key(v)
+		if _, ok := r[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+// DuplicatePolicy decides how collectors handle a key that was already stored
+type DuplicatePolicy int
+
+const (
+	// KeepLast overwrites the earlier value
+	KeepLast DuplicatePolicy = iota
+	// KeepFirst ignores the later value
+	KeepFirst
+	// FailOnDuplicate stops with ErrDuplicateKey
+	FailOnDuplicate
+)
+
+// CollectToMap collects the pairs of seq into a map, handling repeated keys according to policy
+func CollectToMap[K comparable, V any](seq iter.Seq2[K, V], policy DuplicatePolicy) (map[K]V, error) {
+	r := make(map[K]V)
+	for k, v := range seq {
+		if _, ok := r[k]; ok {
+			switch policy {
+			case KeepFirst:
+				continue
+			case FailOnDuplicate:
+				return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+			}
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+// CollectToMapBy collects the elements of seq into a map keyed by key, handling repeated keys according to policy
+func CollectToMapBy[V any, K comparable](seq iter.Seq[V], key func(V) K, policy DuplicatePolicy) (map[K]V, error) {
+	return CollectToMap(func(yield func(K, V) bool) {
+		for v := range seq {
+			if !yield(key(v), v) {
+				return
+			}
+		}
+	}, policy)
+}
+
+// DiffMaps compares old and new. added and changed hold the values from new, removed the values from old.
+func DiffMaps[K, V comparable](old, new map[K]V) (added, removed, changed map[K]V) {
+	return DiffMapsFunc(old, new, func(a, b V) bool { return a == b })
+}
+
+// DiffMapsFunc is DiffMaps with a custom equality function for values
+func DiffMapsFunc[K comparable, V any](old, new map[K]V, eq func(a, b V) bool) (added, removed, changed map[K]V) {
+	added, removed, changed = make(map[K]V), make(map[K]V), make(map[K]V)
+	for k, v := range new {
+		if ov, ok := old[k]; !ok {
+			added[k] = v
+		} else if !eq(ov, v) {
+			changed[k] = v
+		}
+	}
+	for k, v := range old {
+		if _, ok := new[k]; !ok {
+			removed[k] = v
+		}
+	}
+	return added, removed, changed
+}
+
+// IntersectKeys returns the entries of m whose keys are also in other, with the values of m
+func IntersectKeys[K comparable, V, W any](m map[K]V, other map[K]W) map[K]V {
+	r := make(map[K]V)
+	for k, v := range m {
+		if _, ok := other[k]; ok {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// SubtractKeys returns the entries of m whose keys are not in other
+func SubtractKeys[K comparable, V, W any](m map[K]V, other map[K]W) map[K]V {
+	r := make(map[K]V)
+	for k, v := range m {
+		if _, ok := other[k]; !ok {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// UnionKeys returns the entries of both maps. For keys in both, resolve picks the value from the values of m and other.
+func UnionKeys[K comparable, V any](m, other map[K]V, resolve func(key K, fromM, fromOther V) V) map[K]V {
+	return MergeMaps(resolve, m, other)
+}
+
+// TopNByValue returns the n entries of m with the largest values in descending order of value.
+// It keeps a heap of n entries instead of sorting the whole map.
+func TopNByValue[K comparable, V cmp.Ordered](m map[K]V, n int) []collections.Pair[K, V] {
+	if n <= 0 {
+		return nil
+	}
+	// Min-heap of the best entries so far, the smallest on top
+	top := collections.NewPriorityQueue(func(a, b collections.Pair[K, V]) bool { return a.Value < b.Value })
+	for k, v := range m {
+		if top.Len() < n {
+			top.Push(collections.Pair[K, V]{Key: k, Value: v})
+		} else if smallest, _ := top.Peek(); v > smallest.Value {
+			top.Pop()
+			top.Push(collections.Pair[K, V]{Key: k, Value: v})
+		}
+	}
+	res := make([]collections.Pair[K, V], top.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i], _ = top.Pop()
+	}
+	return res
+}
+
+// ErrLengthMismatch is returned when parallel slices have different lengths
+var ErrLengthMismatch = errors.New("slices have different lengths")
+
+// ZipToMap maps keys[i] to values[i]. For duplicate keys the last value wins.
+func ZipToMap[K comparable, V any](keys []K, values []V) (map[K]V, error) {
+	return zipToMap(keys, values, KeepLast)
+}
+
+// ZipToMapUnique is like ZipToMap but fails with ErrDuplicateKey if a key repeats
+func ZipToMapUnique[K comparable, V any](keys []K, values []V) (map[K]V, error) {
+	return zipToMap(keys, values, FailOnDuplicate)
+}
+
+func zipToMap[K comparable, V any](keys []K, values []V, policy DuplicatePolicy) (map[K]V, error) {
+	if len(keys) != len(values) {
+		return nil, ErrLengthMismatch
+	}
+	return CollectToMap(func(yield func(K, V) bool) {
+		for i, k := range keys {
+			if !yield(k, values[i]) {
+				return
+			}
+		}
+	}, policy)
+}
+
+// KeyCollisionError is returned by RekeyMap and lists every new key that several source keys mapped to
+type KeyCollisionError[K1, K2 comparable] struct {
+	Collisions map[K2][]K1
+}
+
+func (e *KeyCollisionError[K1, K2]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrDuplicateKey, e.Collisions)
+}
+
+func (e *KeyCollisionError[K1, K2]) Unwrap() error {
+	return ErrDuplicateKey
+}
+
+// RekeyMap returns m with every key converted by f. If f maps several keys to the same new key,
+// no map is returned and the error lists the colliding source keys.
+func RekeyMap[K1, K2 comparable, V any](m map[K1]V, f func(K1) K2) (map[K2]V, error) {
+	r := make(map[K2]V, len(m))
+	sources := make(map[K2]K1, len(m))
+	var collisions map[K2][]K1
+	for k, v := range m {
+		nk := f(k)
+		if first, ok := sources[nk]; ok {
+			if collisions == nil {
+				collisions = make(map[K2][]K1)
+			}
+			if len(collisions[nk]) == 0 {
+				collisions[nk] = append(collisions[nk], first)
+			}
+			collisions[nk] = append(collisions[nk], k)
+			continue
+		}
+		sources[nk] = k
+		r[nk] = v
+	}
+	if collisions != nil {
+		return nil, &KeyCollisionError[K1, K2]{Collisions: collisions}
+	}
+	return r, nil
+}
+
+// FlattenMap turns nested map[string]any values into a single level, joining the keys along the path
+// with sep. Empty nested maps are kept as values. It fails if two paths join to the same key, as in
+// {"a.b": 1, "a": {"b": 2}}.
+func FlattenMap(m map[string]any, sep string) (map[string]any, error) {
+	r := make(map[string]any)
+	if err := flattenInto(r, "", m, sep); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func flattenInto(dst map[string]any, prefix string, m map[string]any, sep string) error {
+	for k, v := range m {
+		if prefix != "" {
+			k = prefix + sep + k
+		}
+		if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+			if err := flattenInto(dst, k, nested, sep); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := dst[k]; ok {
+			return fmt.Errorf("%w: several paths flatten to %q", ErrDuplicateKey, k)
+		}
+		dst[k] = v
+	}
+	return nil
+}
+
+// UnflattenMap reverses FlattenMap by splitting keys at sep. Nested maps among the values are copied,
+// so keys below them never modify m. It fails if a key is both a value and a prefix of another key,
+// or if a key also occurs inside such a nested map.
+func UnflattenMap(m map[string]any, sep string) (map[string]any, error) {
+	r := make(map[string]any)
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		parts := strings.Split(k, sep)
+		node := r
+		for _, p := range parts[:len(parts)-1] {
+			child, ok := node[p]
+			if !ok {
+				child = make(map[string]any)
+				node[p] = child
+			}
+			next, ok := child.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: %q is a value and a parent of %q", ErrDuplicateKey, p, k)
+			}
+			node = next
+		}
+		last := parts[len(parts)-1]
+		if _, ok := node[last]; ok {
+			return nil, fmt.Errorf("%w: %q collides with a nested key", ErrDuplicateKey, k)
+		}
+		v := m[k]
+		if nested, ok := v.(map[string]any); ok {
+			v = copyNested(nested)
+		}
+		node[last] = v
+	}
+	return r, nil
+}
+
+// copyNested returns a deep copy of m, descending into map[string]any values
+func copyNested(m map[string]any) map[string]any {
+	r := make(map[string]any, len(m))
+	for k, v := range m {
+		if nested, ok := v.(map[string]any); ok {
+			v = copyNested(nested)
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// FlattenNested turns a two-level map into one keyed by both keys
+func FlattenNested[K comparable, V any](m map[K]map[K]V) map[[2]K]V {
+	r := make(map[[2]K]V)
+	for k1, inner := range m {
+		for k2, v := range inner {
+			r[[2]K{k1, k2}] = v
+		}
+	}
+	return r
+}
+
+// UnflattenNested reverses FlattenNested
+func UnflattenNested[K comparable, V any](m map[[2]K]V) map[K]map[K]V {
+	r := make(map[K]map[K]V)
+	for k, v := range m {
+		inner, ok := r[k[0]]
+		if !ok {
+			inner = make(map[K]V)
+			r[k[0]] = inner
+		}
+		inner[k[1]] = v
+	}
+	return r
+}
+
+// Frequencies counts how often each item occurs. Pass the result to collections.CounterFrom for MostCommon reporting.
+func Frequencies[V comparable](items []V) map[V]int {
+	return FrequenciesBy(items, func(v V) V { return v })
+}
+
+// FrequenciesBy counts the items per key
+func FrequenciesBy[V any, K comparable](items []V, key func(V) K) map[K]int {
+	r := make(map[K]int)
+	for _, v := range items {
+		r[key(v)]++
+	}
+	return r
+}
+
+// SampleMap picks min(k, len(m)) entries uniformly at random using reservoir sampling, so only k keys
+// are held at a time. A nil rng uses the global source. Since map iteration order is itself random,
+// a seeded rng fixes the sampling decisions but not which keys they fall on; use SampleMapFunc or
+// SampleMapOrdered for reproducible samples.
+func SampleMap[K comparable, V any](m map[K]V, k int, rng *rand.Rand) map[K]V {
+	return sampleKeys(m, maps.Keys(m), k, rng)
+}
+
+// SampleMapFunc is like SampleMap but visits the keys in the order given by cmp, so a seeded rng
+// always selects the same entries. It sorts a copy of all keys first.
+func SampleMapFunc[K comparable, V any](m map[K]V, k int, cmp func(a, b K) int, rng *rand.Rand) map[K]V {
+	return sampleKeys(m, slices.Values(slices.SortedFunc(maps.Keys(m), cmp)), k, rng)
+}
+
+// SampleMapOrdered is SampleMapFunc with the natural order of the keys
+func SampleMapOrdered[K cmp.Ordered, V any](m map[K]V, k int, rng *rand.Rand) map[K]V {
+	return SampleMapFunc(m, k, cmp.Compare[K], rng)
+}
+
+// sampleKeys draws min(k, len(m)) of the keys yielded by keys with reservoir sampling
+func sampleKeys[K comparable, V any](m map[K]V, keys iter.Seq[K], k int, rng *rand.Rand) map[K]V {
+	if k <= 0 {
+		return map[K]V{}
+	}
+	intN := rand.IntN
+	if rng != nil {
+		intN = rng.IntN
+	}
+	reservoir := make([]K, 0, min(k, len(m)))
+	i := 0
+	for key := range keys {
+		if i < k {
+			reservoir = append(reservoir, key)
+		} else if j := intN(i + 1); j < k {
+			reservoir[j] = key
+		}
+		i++
+	}
+	r := make(map[K]V, len(reservoir))
+	for _, key := range reservoir {
+		r[key] = m[key]
+	}
+	return r
+}
+
+// GetOr returns m[k], or def if k is not present
+func GetOr[K comparable, V any](m map[K]V, k K, def V) V {
+	if v, ok := m[k]; ok {
+		return v
+	}
+	return def
+}
+
+// GetOrInsert returns m[k], storing the result of create first if k is not present
+func GetOrInsert[K comparable, V any](m map[K]V, k K, create func() V) V {
+	v, ok := m[k]
+	if !ok {
+		v = create()
+		m[k] = v
+	}
+	return v
+}
@@ -0,0 +1,69 @@
+package maputil
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func TestSampleMapOrdered(t *testing.T) {
+	m := make(map[int]string)
+	for i := range 100 {
+		m[i] = string(rune('a' + i%26))
+	}
+	first := SampleMapOrdered(m, 5, rand.New(rand.NewPCG(1, 2)))
+	if len(first) != 5 {
+		t.Fatalf("sampled %d entries, want 5", len(first))
+	}
+	for range 10 {
+		if got := SampleMapOrdered(m, 5, rand.New(rand.NewPCG(1, 2))); !maps.Equal(got, first) {
+			t.Fatalf("same seed sampled %v, then %v", first, got)
+		}
+	}
+	if got := SampleMapOrdered(m, 200, nil); !maps.Equal(got, m) {
+		t.Errorf("oversized sample left out entries: %d of %d", len(got), len(m))
+	}
+}
+
+func TestFlattenMap(t *testing.T) {
+	m := map[string]any{"a": map[string]any{"b": 1, "c": map[string]any{"d": 2}}, "e": map[string]any{}}
+	flat, err := FlattenMap(m, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]any{"a.b": 1, "a.c.d": 2, "e": map[string]any{}}; !reflect.DeepEqual(flat, want) {
+		t.Errorf("FlattenMap = %v, want %v", flat, want)
+	}
+	back, err := UnflattenMap(flat, ".")
+	if err != nil || !reflect.DeepEqual(back, m) {
+		t.Errorf("UnflattenMap = %v, %v, want %v", back, err, m)
+	}
+
+	if _, err := FlattenMap(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}}, "."); !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("FlattenMap with colliding paths: %v, want %v", err, ErrDuplicateKey)
+	}
+}
+
+func TestUnflattenMapCopiesNested(t *testing.T) {
+	nested := map[string]any{"x": 1}
+	r, err := UnflattenMap(map[string]any{"a": nested, "a.b": 2}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nested) != 1 {
+		t.Errorf("UnflattenMap modified its input: %v", nested)
+	}
+	if want := map[string]any{"a": map[string]any{"x": 1, "b": 2}}; !reflect.DeepEqual(r, want) {
+		t.Errorf("UnflattenMap = %v, want %v", r, want)
+	}
+	for _, m := range []map[string]any{
+		{"a": map[string]any{"b": 1}, "a.b": 2},
+		{"a": 1, "a.b": 2},
+	} {
+		if _, err := UnflattenMap(m, "."); !errors.Is(err, ErrDuplicateKey) {
+			t.Errorf("UnflattenMap(%v) = %v, want %v", m, err, ErrDuplicateKey)
+		}
+	}
+}
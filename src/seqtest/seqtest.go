@@ -0,0 +1,147 @@
+// Package seqtest checks that iterators obey the iterator laws. Like testing/iotest and testing/fstest
+// it can be used from tests, fuzz targets and ordinary programs: every check reports a violation as an error.
+package seqtest
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"runtime"
+	"time"
+)
+
+var (
+	// ErrYieldAfterFalse is reported when a sequence keeps calling yield after it returned false
+	ErrYieldAfterFalse = errors.New("yield called after it returned false")
+	// ErrNotReiterable is reported when two iterations of a pure source produce different elements
+	ErrNotReiterable = errors.New("re-iteration produced different elements")
+	// ErrGoroutineLeak is reported when goroutines started by a sequence outlive the iteration
+	ErrGoroutineLeak = errors.New("goroutines leaked")
+)
+
+// LeakTimeout is how long NoLeak waits for goroutines to exit before reporting a leak
+var LeakTimeout = time.Second
+
+// pairs turns a Seq2 into a Seq of its pairs so that the checks only need to be written once
+func pairs[K, V any](seq iter.Seq2[K, V]) iter.Seq[[2]any] {
+	return func(yield func([2]any) bool) {
+		seq(func(k K, v V) bool { return yield([2]any{k, v}) })
+	}
+}
+
+// count returns the number of elements of seq, but at most limit
+func count[V any](seq iter.Seq[V], limit int) int {
+	n := 0
+	seq(func(V) bool {
+		n++
+		return n < limit
+	})
+	return n
+}
+
+// EarlyStop stops the iteration of seq after every possible number of elements up to limit and checks
+// that yield is never called again once it returned false
+func EarlyStop[V any](seq iter.Seq[V], limit int) error {
+	n := count(seq, limit)
+	for stop := 1; stop <= n; stop++ {
+		calls, done := 0, false
+		var err error
+		seq(func(V) bool {
+			if done && err == nil {
+				err = fmt.Errorf("%w: stopped after %d elements", ErrYieldAfterFalse, stop)
+			}
+			calls++
+			done = calls >= stop
+			return !done
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EarlyStop2 is EarlyStop for iter.Seq2
+func EarlyStop2[K, V any](seq iter.Seq2[K, V], limit int) error {
+	return EarlyStop(pairs(seq), limit)
+}
+
+// Reiterate iterates seq twice, up to limit elements each, and checks that both runs produce the same
+// elements. It only applies to pure sources such as collection iterators.
+func Reiterate[V any](seq iter.Seq[V], limit int) error {
+	collect := func() []V {
+		var s []V
+		seq(func(v V) bool {
+			s = append(s, v)
+			return len(s) < limit
+		})
+		return s
+	}
+	first, second := collect(), collect()
+	if !reflect.DeepEqual(first, second) {
+		return fmt.Errorf("%w: %v, then %v", ErrNotReiterable, first, second)
+	}
+	return nil
+}
+
+// Reiterate2 is Reiterate for iter.Seq2
+func Reiterate2[K, V any](seq iter.Seq2[K, V], limit int) error {
+	return Reiterate(pairs(seq), limit)
+}
+
+// NoLeak runs f and checks that the number of goroutines drops back to its previous level within LeakTimeout
+func NoLeak(f func()) error {
+	before := runtime.NumGoroutine()
+	f()
+	deadline := time.Now().Add(LeakTimeout)
+	for {
+		after := runtime.NumGoroutine()
+		if after <= before {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w: %d goroutines before, %d after", ErrGoroutineLeak, before, after)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// NoLeakOnBreak breaks out of seq after every possible number of elements up to limit, and also runs it to
+// the end if it is shorter than limit, checking that no goroutines are left behind. This catches adapters
+// that forget to stop an iter.Pull.
+func NoLeakOnBreak[V any](seq iter.Seq[V], limit int) error {
+	n := count(seq, limit)
+	for stop := 1; stop <= n+1; stop++ {
+		if stop > limit {
+			break
+		}
+		err := NoLeak(func() {
+			i := 0
+			for range seq {
+				if i++; i == stop {
+					break
+				}
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("stopping after %d elements: %w", stop, err)
+		}
+	}
+	return nil
+}
+
+// NoLeakOnBreak2 is NoLeakOnBreak for iter.Seq2
+func NoLeakOnBreak2[K, V any](seq iter.Seq2[K, V], limit int) error {
+	return NoLeakOnBreak(pairs(seq), limit)
+}
+
+// Check runs EarlyStop, Reiterate and NoLeakOnBreak on seq and joins their errors
+func Check[V any](seq iter.Seq[V], limit int) error {
+	return errors.Join(EarlyStop(seq, limit), Reiterate(seq, limit), NoLeakOnBreak(seq, limit))
+}
+
+// Check2 is Check for iter.Seq2
+func Check2[K, V any](seq iter.Seq2[K, V], limit int) error {
+	return Check(pairs(seq), limit)
+}
@@ -1,56 +0,0 @@
-package main
-
-import "iter"
-
-type Stack[T any] struct {
-	data []T
-}
-
-func NewStack[T any]() *Stack[T] {
-	return &Stack[T]{}
-}
-
-func (s *Stack[T]) Push(value T) {
-	s.data = append(s.data, value)
-}
-
-func (s *Stack[T]) Pop() (T, bool) {
-	if len(s.data) == 0 {
-		var zero T
-		return zero, false
-	}
-	index := len(s.data) - 1
-	val := s.data[index]
-	s.data = s.data[:index]
-	return val, true
-}
-
-func (s *Stack[T]) Peek() (T, bool) {
-	if len(s.data) == 0 {
-		var zero T
-		return zero, false
-	}
-	return s.data[len(s.data)-1], true
-}
-
-func (s *Stack[T]) Len() int {
-	return len(s.data)
-}
-
-func (s *Stack[T]) IsEmpty() bool {
-	return len(s.data) == 0
-}
-
-// All() Function returns an iterator over all elements in the stack
-func (s *Stack[T]) All() iter.Seq[T] {
-	return func(yield func(T) bool) {
-		for _, v := range s.data {
-			if !yield(v) {
-				return
-			}
-		}
-	}
-}
-
-
-